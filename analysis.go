@@ -0,0 +1,624 @@
+package main
+
+import (
+	"math"
+	"sort"
+)
+
+// Pairing representa a pontuação combinada de um confronto entre duas estratégias
+type Pairing struct {
+	nameA, nameB string
+	combined     float64 // pontos somados das duas estratégias, por jogo
+}
+
+// pairingGames retorna quantos jogos i e j disputaram entre si, dado o número de cópias de cada.
+// Entre estratégias diferentes cada par de cópias joga duas vezes (uma em cada posição); entre
+// cópias da mesma estratégia há um jogo por par ordenado, em que a diagonal já soma os dois lados
+func pairingGames(counts []int, i, j int) int {
+	if i == j {
+		return counts[i] * counts[i]
+	}
+	return 2 * counts[i] * counts[j]
+}
+
+// pairingScore calcula a pontuação combinada por jogo entre i e j a partir da matriz de confrontos
+func pairingScore(matrix [][]int, counts []int, i, j int) float64 {
+	games := pairingGames(counts, i, j)
+	if i == j {
+		return float64(matrix[i][i]) / float64(games)
+	}
+	return float64(matrix[i][j]+matrix[j][i]) / float64(games)
+}
+
+// extremePairings retorna o confronto com maior pontuação combinada (o mais cooperativo) e o de
+// menor pontuação combinada (o mais hostil); ok é false se nenhum confronto foi disputado
+func extremePairings(matrix [][]int, names []string, counts []int) (cooperative, hostile Pairing, ok bool) {
+	for i := range matrix {
+		for j := i; j < len(matrix); j++ {
+			if pairingGames(counts, i, j) == 0 {
+				continue
+			}
+			pairing := Pairing{nameA: names[i], nameB: names[j], combined: pairingScore(matrix, counts, i, j)}
+			if !ok || pairing.combined > cooperative.combined {
+				cooperative = pairing
+			}
+			if !ok || pairing.combined < hostile.combined {
+				hostile = pairing
+			}
+			ok = true
+		}
+	}
+	return cooperative, hostile, ok
+}
+
+// pointsPerGame retorna a média de pontos que i fez por jogo contra k, levando em conta o número
+// de cópias de cada (na diagonal, a matriz soma os dois lados de cada jogo de i contra si mesma)
+func pointsPerGame(matrix [][]int, counts []int, i, k int) float64 {
+	return float64(matrix[i][k]) / float64(2*counts[i]*counts[k])
+}
+
+// Dominance indica que a estratégia dominated fez, contra cada oponente, no máximo os pontos
+// de by, e estritamente menos contra pelo menos um
+type Dominance struct {
+	dominated, by string
+}
+
+// dominatedStrategies encontra as relações de dominância entre as estratégias que participaram
+// do torneio, comparando os pontos por jogo de cada uma contra cada oponente
+func dominatedStrategies(matrix [][]int, names []string, counts []int) []Dominance {
+	var played []int
+	for i, count := range counts {
+		if count > 0 {
+			played = append(played, i)
+		}
+	}
+
+	var relations []Dominance
+	for _, i := range played {
+		for _, j := range played {
+			if i == j {
+				continue
+			}
+			dominated, strict := true, false
+			for _, k := range played {
+				pi, pj := pointsPerGame(matrix, counts, i, k), pointsPerGame(matrix, counts, j, k)
+				if pi > pj {
+					dominated = false
+					break
+				}
+				if pi < pj {
+					strict = true
+				}
+			}
+			if dominated && strict {
+				relations = append(relations, Dominance{dominated: names[i], by: names[j]})
+			}
+		}
+	}
+	return relations
+}
+
+// buildDominanceGraph monta o grafo de "quem explora quem" a partir da matriz de confrontos: há
+// uma aresta {i, j} quando names[i] fez mais de threshold pontos a mais que names[j] no confronto
+func buildDominanceGraph(matrix [][]int, names []string, threshold int) [][2]int {
+	var edges [][2]int
+	for i := range names {
+		for j := range names {
+			if i != j && matrix[i][j]-matrix[j][i] > threshold {
+				edges = append(edges, [2]int{i, j})
+			}
+		}
+	}
+	return edges
+}
+
+// perGameMatrix reduz a matriz de confrontos às estratégias que participaram, com os pontos por
+// jogo (arredondados) no lugar dos totais, para que as cópias não distorçam a comparação
+func perGameMatrix(matrix [][]int, names []string, counts []int) ([][]int, []string) {
+	var played []int
+	var playedNames []string
+	for i, count := range counts {
+		if count > 0 {
+			played = append(played, i)
+			playedNames = append(playedNames, names[i])
+		}
+	}
+	reduced := make([][]int, len(played))
+	for a, i := range played {
+		reduced[a] = make([]int, len(played))
+		for b, k := range played {
+			reduced[a][b] = int(math.Round(pointsPerGame(matrix, counts, i, k)))
+		}
+	}
+	return reduced, playedNames
+}
+
+// StrategyMetrics reúne métricas de comportamento de uma estratégia, medidas em jogos contra
+// todas as estratégias (incluindo ela mesma)
+type StrategyMetrics struct {
+	name        string
+	score       float64 // pontos médios por jogo
+	coopRate    float64 // fração das jogadas em que cooperou
+	niceness    float64 // fração dos jogos em que não foi a primeira a trair
+	retaliation float64 // fração das traições do oponente respondidas com traição na rodada seguinte
+	forgiveness float64 // fração das vezes em que voltou a cooperar após o oponente voltar a cooperar
+}
+
+// metricAxes retorna os nomes dos eixos de StrategyMetrics, na ordem de values
+func metricAxes() []string {
+	return []string{tr("metric_score"), tr("metric_cooperation"), tr("metric_niceness"),
+		tr("metric_retaliation"), tr("metric_forgiveness")}
+}
+
+// values retorna as métricas na ordem de metricAxes
+func (m StrategyMetrics) values() []float64 {
+	return []float64{m.score, m.coopRate, m.niceness, m.retaliation, m.forgiveness}
+}
+
+// ratio retorna num/den, ou 0 se den for zero
+func ratio(num, den int) float64 {
+	if den == 0 {
+		return 0
+	}
+	return float64(num) / float64(den)
+}
+
+// firstDefection retorna o índice da primeira traição em moves, ou -1 se não houver
+func firstDefection(moves []Choice) int {
+	for i, move := range moves {
+		if move == Defect {
+			return i
+		}
+	}
+	return -1
+}
+
+// computeMetrics joga cada estratégia contra todas as outras (incluindo ela mesma) e mede seu
+// comportamento a partir dos históricos dos jogos
+func computeMetrics(strategies []Strategy, rounds int) []StrategyMetrics {
+	metrics := make([]StrategyMetrics, 0, len(strategies))
+	for _, s := range strategies {
+		var points, moves, cooperations, games, niceGames int
+		var provocations, retaliations, reconciliations, forgivings int
+		for _, opponent := range strategies {
+			game := NewGame(freshInstance(s), freshInstance(opponent), rounds)
+			for round := 0; round < rounds; round++ {
+				if game.PlayRound(round) != nil {
+					break
+				}
+			}
+			own, opp := game.movesA, game.movesB
+
+			points += game.scores[0]
+			games++
+			moves += len(own)
+			for _, move := range own {
+				if move == Cooperate {
+					cooperations++
+				}
+			}
+
+			// Gentil: o oponente traiu antes (ou ninguém traiu); trair na mesma rodada não conta
+			first, oppFirst := firstDefection(own), firstDefection(opp)
+			if first < 0 || (oppFirst >= 0 && oppFirst < first) {
+				niceGames++
+			}
+
+			for t := 0; t+1 < len(own); t++ {
+				// Retaliação: o oponente traiu em t e a estratégia traiu em t+1
+				if opp[t] == Defect {
+					provocations++
+					if own[t+1] == Defect {
+						retaliations++
+					}
+				}
+				// Perdão: o oponente traiu em t-1, voltou a cooperar em t e a estratégia cooperou em t+1
+				if t > 0 && opp[t-1] == Defect && opp[t] == Cooperate {
+					reconciliations++
+					if own[t+1] == Cooperate {
+						forgivings++
+					}
+				}
+			}
+		}
+		metrics = append(metrics, StrategyMetrics{
+			name:        s.Name(),
+			score:       ratio(points, games),
+			coopRate:    ratio(cooperations, moves),
+			niceness:    ratio(niceGames, games),
+			retaliation: ratio(retaliations, provocations),
+			forgiveness: ratio(forgivings, reconciliations),
+		})
+	}
+	return metrics
+}
+
+// normalizeMetrics divide cada eixo pelo maior valor entre as métricas selecionadas, de modo
+// que a melhor estratégia do conjunto em cada eixo fique em 1; eixos sem valores positivos ficam em 0
+func normalizeMetrics(metrics []StrategyMetrics) [][]float64 {
+	axes := len(metricAxes())
+	maxima := make([]float64, axes)
+	for _, m := range metrics {
+		for k, v := range m.values() {
+			maxima[k] = math.Max(maxima[k], v)
+		}
+	}
+	normalized := make([][]float64, len(metrics))
+	for i, m := range metrics {
+		normalized[i] = make([]float64, axes)
+		for k, v := range m.values() {
+			if maxima[k] > 0 {
+				normalized[i][k] = v / maxima[k]
+			}
+		}
+	}
+	return normalized
+}
+
+// cooperationByRound joga cada estratégia contra todas as outras (incluindo ela mesma), como no
+// torneio semeado por seed, e retorna, para cada rodada, a taxa média de cooperação de todos os
+// jogadores em todos os jogos
+func cooperationByRound(strategies []Strategy, rounds int, seed int64) []float64 {
+	return playTournament(strategies, TournamentConfig{Rounds: rounds, Seed: seed}).cooperationCurve()
+}
+
+// cooperationCurve retorna a taxa de cooperação de cada rodada nos confrontos já disputados
+func (c *TournamentCheckpoint) cooperationCurve() []float64 {
+	curve := make([]float64, len(c.RoundMoves))
+	for round, moves := range c.RoundMoves {
+		curve[round] = ratio(c.RoundCooperations[round], moves)
+	}
+	return curve
+}
+
+// tftDeviations conta as rodadas em que ownMoves difere do que Tit-for-Tat teria jogado com o
+// mesmo histórico do oponente (cooperar na primeira rodada e depois repetir o oponente)
+func tftDeviations(ownMoves, opponentMoves []Choice) int {
+	deviations := 0
+	for t, move := range ownMoves {
+		predicted := Cooperate
+		if t > 0 && t-1 < len(opponentMoves) {
+			predicted = opponentMoves[t-1]
+		}
+		if move != predicted {
+			deviations++
+		}
+	}
+	return deviations
+}
+
+// deviationFromTFT retorna a fração das rodadas em que a estratégia se desviou da previsão de
+// Tit-for-Tat: perto de 0 indica uma estratégia reativa, perto de 1, uma independente do oponente
+func deviationFromTFT(ownMoves, opponentMoves []Choice) float64 {
+	return ratio(tftDeviations(ownMoves, opponentMoves), len(ownMoves))
+}
+
+// playSwapped joga de novo o confronto de game com os papéis trocados (B na posição de A e
+// vice-versa), com instâncias novas das estratégias e a mesma configuração: matriz, bônus de
+// cooperação, penalidade por traição mútua, janela de histórico e pontuações iniciais (trocadas).
+// Cada estratégia recebe a semente que tinha em game, e são jogadas tantas rodadas quanto em game,
+// que pode ter parado antes do fim ao atingir uma pontuação alvo
+func playSwapped(game *Game) *Game {
+	swapped := NewGame(freshInstance(game.strategyB), freshInstance(game.strategyA), game.rounds)
+	swapped.SetPayoff(game.payoff)
+	swapped.SetCooperationBonus(game.coopBonus)
+	swapped.SetDefectionEscalation(game.defectEscalation)
+	swapped.SetHistoryWindow(game.historyWindow)
+	swapped.SetStartingScores(game.handicap[1], game.handicap[0])
+	if game.seeded {
+		swapped.seedSides(game.seeds[1], game.seeds[0])
+	}
+	for round := 0; round < len(game.movesA); round++ {
+		if swapped.PlayRound(round) != nil {
+			break
+		}
+	}
+	return swapped
+}
+
+// swappedAverage retorna os pontos ganhos em média por A e por B nas duas ordens do confronto,
+// removendo efeitos de posição; swapped é o jogo com os papéis trocados, como retornado por
+// playSwapped. As pontuações iniciais não entram na média, só o que foi ganho nas rodadas
+func swappedAverage(game, swapped *Game) (scoreA, scoreB float64) {
+	earned := func(g *Game, side int) int { return g.scores[side] - g.handicap[side] }
+	return float64(earned(game, 0)+earned(swapped, 1)) / 2, float64(earned(game, 1)+earned(swapped, 0)) / 2
+}
+
+// confidenceInterval95 retorna a média das amostras e a margem do intervalo de confiança de
+// 95% (aproximação normal, 1,96 desvios-padrão da média); com menos de duas amostras a margem é 0
+func confidenceInterval95(samples []float64) (mean, margin float64) {
+	n := len(samples)
+	if n == 0 {
+		return 0, 0
+	}
+	for _, x := range samples {
+		mean += x
+	}
+	mean /= float64(n)
+	if n < 2 {
+		return mean, 0
+	}
+	variance := 0.0
+	for _, x := range samples {
+		variance += (x - mean) * (x - mean)
+	}
+	variance /= float64(n - 1)
+	return mean, 1.96 * math.Sqrt(variance/float64(n))
+}
+
+// repeatTournament executa o torneio reps vezes e retorna a classificação e a matriz da primeira
+// repetição, a pontuação total de cada estratégia em cada repetição e a taxa de cooperação de
+// cada rodada, somando os jogos de todas as repetições
+func repeatTournament(strategies []Strategy, cfg TournamentConfig, reps int) ([]Result, [][]int, map[string][]float64, []float64) {
+	var results []Result
+	var matrix [][]int
+	samples := make(map[string][]float64)
+	// Sem estratégias não há confrontos: resultados vazios em vez de uma matriz inválida
+	if len(strategies) == 0 {
+		return []Result{}, [][]int{}, samples, nil
+	}
+	total := newTournamentCheckpoint(nil, cfg) // Só acumula a cooperação por rodada
+	for rep := 0; rep < reps; rep++ {
+		repCfg := cfg
+		if cfg.Live != nil {
+			cfg.Live.startRepetition(rep + 1)
+		}
+		if cfg.Seed != 0 {
+			// Cada repetição precisa de sementes diferentes, ou todas seriam idênticas
+			repCfg.Seed = cfg.Seed + int64(rep)*int64(len(tournamentPairings(strategies, cfg)))
+		}
+		checkpoint := playTournament(strategies, repCfg)
+		repResults, repMatrix := checkpoint.results(strategies)
+		if rep == 0 {
+			results, matrix = repResults, repMatrix
+		}
+		for _, result := range repResults {
+			samples[result.name] = append(samples[result.name], float64(result.score))
+		}
+		for round := range total.RoundMoves {
+			total.RoundMoves[round] += checkpoint.RoundMoves[round]
+			total.RoundCooperations[round] += checkpoint.RoundCooperations[round]
+		}
+	}
+	return results, matrix, samples, total.cooperationCurve()
+}
+
+// RankDelta é a posição e a pontuação de uma estratégia em dois torneios comparados
+type RankDelta struct {
+	name           string
+	rankA, rankB   int // 0 se a estratégia não participou do torneio
+	scoreA, scoreB int
+}
+
+// moved retorna quantas posições a estratégia subiu de A para B (negativo se caiu), ou 0 se ela
+// não participou dos dois torneios
+func (d RankDelta) moved() int {
+	if d.rankA == 0 || d.rankB == 0 {
+		return 0
+	}
+	return d.rankA - d.rankB
+}
+
+// diffTournaments compara as classificações de dois torneios. As estratégias que mais mudaram de
+// posição vêm primeiro (empates pela posição em B); as que só participaram de um deles vêm no fim
+func diffTournaments(a, b []Result) []RankDelta {
+	index := make(map[string]int, len(a))
+	var deltas []RankDelta
+	for _, result := range a {
+		index[result.name] = len(deltas)
+		deltas = append(deltas, RankDelta{name: result.name, rankA: result.rank, scoreA: result.score})
+	}
+	for _, result := range b {
+		i, ok := index[result.name]
+		if !ok {
+			i = len(deltas)
+			deltas = append(deltas, RankDelta{name: result.name})
+		}
+		deltas[i].rankB, deltas[i].scoreB = result.rank, result.score
+	}
+
+	sort.SliceStable(deltas, func(i, j int) bool {
+		di, dj := deltas[i], deltas[j]
+		bothI, bothJ := di.rankA > 0 && di.rankB > 0, dj.rankA > 0 && dj.rankB > 0
+		if bothI != bothJ {
+			return bothI
+		}
+		if !bothI {
+			return di.name < dj.name
+		}
+		if abs(di.moved()) != abs(dj.moved()) {
+			return abs(di.moved()) > abs(dj.moved())
+		}
+		return di.rankB < dj.rankB
+	})
+	return deltas
+}
+
+// OutcomeCounts conta os resultados das rodadas de um jogo, do ponto de vista do jogador A
+type OutcomeCounts struct {
+	mutualCooperation int // Ambos cooperaram
+	suckerA           int // A cooperou e foi traído (explorado)
+	temptationA       int // A traiu quem cooperou (explorou)
+	mutualDefection   int // Ambos traíram
+}
+
+// countOutcomes conta os resultados das rodadas dos históricos de A e B
+func countOutcomes(movesA, movesB []Choice) OutcomeCounts {
+	var counts OutcomeCounts
+	for i := 0; i < len(movesA) && i < len(movesB); i++ {
+		switch {
+		case movesA[i] == Cooperate && movesB[i] == Cooperate:
+			counts.mutualCooperation++
+		case movesA[i] == Cooperate:
+			counts.suckerA++
+		case movesB[i] == Cooperate:
+			counts.temptationA++
+		default:
+			counts.mutualDefection++
+		}
+	}
+	return counts
+}
+
+// StrategyCard resume o desempenho de uma estratégia em um jogo do modo normal
+type StrategyCard struct {
+	name, description string
+	coopRate          float64
+	score             int
+	exploited         int // Rodadas em que cooperou e foi traída
+	exploiter         int // Rodadas em que traiu quem cooperou
+}
+
+// matchCards monta os cartões de resumo das duas estratégias de um jogo já disputado
+func matchCards(game *Game) [2]StrategyCard {
+	counts := countOutcomes(game.movesA, game.movesB)
+	return [2]StrategyCard{
+		{
+			name:        game.strategyA.Name(),
+			description: strategyDescription(game.strategyA),
+			coopRate:    cooperationRate(game.movesA),
+			score:       game.scores[0],
+			exploited:   counts.suckerA,
+			exploiter:   counts.temptationA,
+		},
+		{
+			name:        game.strategyB.Name(),
+			description: strategyDescription(game.strategyB),
+			coopRate:    cooperationRate(game.movesB),
+			score:       game.scores[1],
+			exploited:   counts.temptationA,
+			exploiter:   counts.suckerA,
+		},
+	}
+}
+
+// correlationWindow é o número de rodadas da janela da correlação das jogadas no modo normal
+const correlationWindow = 10
+
+// windowedCorrelation retorna, para cada rodada, o coeficiente de correlação de Pearson entre as
+// jogadas de A e de B (1 = cooperar, 0 = trair) nas últimas window rodadas até ela. Perto de 1,
+// os jogadores estão sincronizados; perto de -1, se alternam em ciclos de exploração. É NaN quando
+// indefinido: menos de duas rodadas ou um dos jogadores sem variar (por exemplo, sempre cooperando)
+func windowedCorrelation(movesA, movesB []Choice, window int) []float64 {
+	n := min(len(movesA), len(movesB))
+	correlations := make([]float64, n)
+	value := func(move Choice) float64 {
+		if move == Cooperate {
+			return 1
+		}
+		return 0
+	}
+	for i := 0; i < n; i++ {
+		start := max(0, i-window+1)
+		count := float64(i - start + 1)
+		var sumA, sumB, sumAA, sumBB, sumAB float64
+		for k := start; k <= i; k++ {
+			a, b := value(movesA[k]), value(movesB[k])
+			sumA, sumB = sumA+a, sumB+b
+			sumAA, sumBB, sumAB = sumAA+a*a, sumBB+b*b, sumAB+a*b
+		}
+		varianceA := count*sumAA - sumA*sumA
+		varianceB := count*sumBB - sumB*sumB
+		if count < 2 || varianceA == 0 || varianceB == 0 {
+			correlations[i] = math.NaN()
+			continue
+		}
+		correlations[i] = (count*sumAB - sumA*sumB) / math.Sqrt(varianceA*varianceB)
+	}
+	return correlations
+}
+
+// essTopCount é quantas das primeiras colocadas têm a estabilidade evolutiva verificada
+const essTopCount = 3
+
+// seededMatch joga um confronto de rounds rodadas entre instâncias novas de a e b, semeadas a
+// partir de seed e dos nomes, e retorna a pontuação de cada lado
+func seededMatch(a, b Strategy, rounds int, seed int64) (scoreA, scoreB int) {
+	game := NewGame(freshInstance(a), freshInstance(b), rounds)
+	game.seedSides(pairingSeed(seed, a.Name(), b.Name(), 0), pairingSeed(seed, b.Name(), a.Name(), 0))
+	for round := 0; round < rounds; round++ {
+		if game.PlayRound(round) != nil {
+			break
+		}
+	}
+	return game.scores[0], game.scores[1]
+}
+
+// selfPlayScore é a pontuação média de uma estratégia contra uma cópia de si mesma
+func selfPlayScore(s Strategy, rounds int, seed int64) float64 {
+	scoreA, scoreB := seededMatch(s, s, rounds, seed)
+	return float64(scoreA+scoreB) / 2
+}
+
+// isEvolutionarilyStable verifica se a estratégia chamada target, em uma população só de cópias
+// dela, resiste à invasão de um mutante de cada uma das outras estratégias: para todo mutante M,
+// E(T,T) > E(M,T), ou E(T,T) = E(M,T) e E(T,M) > E(M,M), onde E(X,Y) é a pontuação de X contra Y.
+// Retorna false se target não estiver entre as estratégias
+func isEvolutionarilyStable(strategies []Strategy, rounds int, seed int64, target string) bool {
+	var resident Strategy
+	for _, s := range strategies {
+		if s.Name() == target {
+			resident = s
+		}
+	}
+	if resident == nil {
+		return false
+	}
+	residentScore := selfPlayScore(resident, rounds, seed)
+	for _, mutant := range strategies {
+		if mutant.Name() == target {
+			continue
+		}
+		againstMutant, mutantScore := seededMatch(resident, mutant, rounds, seed)
+		switch {
+		case residentScore > float64(mutantScore):
+		case residentScore == float64(mutantScore) && float64(againstMutant) > selfPlayScore(mutant, rounds, seed):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// qualityIterations é o número de refinamentos da força dos oponentes em qualityAdjustedScores, e
+// qualityDamping o peso da pontuação na força (o resto é igual para todos, como no PageRank), que
+// impede que as estratégias mais fracas fiquem com força zero
+const (
+	qualityIterations = 50
+	qualityDamping    = 0.85
+)
+
+// qualityAdjustedScores repondera os pontos de cada estratégia pela força de cada oponente, em um
+// refinamento iterativo parecido com o PageRank: a pontuação ajustada soma os pontos contra cada
+// oponente multiplicados pela força dele, e a força vem da pontuação ajustada, normalizada para
+// média 1. Na primeira iteração, com forças iguais, a pontuação ajustada é a total
+func qualityAdjustedScores(matrix [][]int, names []string) map[string]float64 {
+	n := len(names)
+	strength := make([]float64, n)
+	for i := range strength {
+		strength[i] = 1
+	}
+	adjusted := make([]float64, n)
+	for iteration := 0; iteration < qualityIterations; iteration++ {
+		total := 0.0
+		for i := range adjusted {
+			adjusted[i] = 0
+			for j, points := range matrix[i] {
+				adjusted[i] += float64(points) * strength[j]
+			}
+			total += adjusted[i]
+		}
+		if total == 0 {
+			break
+		}
+		for i := range strength {
+			strength[i] = 1 - qualityDamping + qualityDamping*adjusted[i]*float64(n)/total
+		}
+	}
+
+	scores := make(map[string]float64, n)
+	for i, name := range names {
+		scores[name] = adjusted[i]
+	}
+	return scores
+}
@@ -0,0 +1,486 @@
+package main
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestCooperationByRoundAlwaysDefectIsFlatZero(t *testing.T) {
+	curve := cooperationByRound([]Strategy{AlwaysDefect{}}, 50, 1)
+	if len(curve) != 50 {
+		t.Fatalf("curva com %d rodadas, esperado 50", len(curve))
+	}
+	for round, rate := range curve {
+		if rate != 0 {
+			t.Errorf("rodada %d: cooperação %.2f, esperado 0", round+1, rate)
+		}
+	}
+}
+
+func TestCooperationCurveDescribesTheTournamentPlayed(t *testing.T) {
+	forbidGlobalRNG(t)
+	strategies := []Strategy{TitForTat{}, &Joss{}, &Random{}, AlwaysDefect{}}
+	cfg := TournamentConfig{Rounds: 30, Seed: 9, Weights: map[string]int{"Random": 3}, HistoryWindow: 2}
+
+	// A curva do torneio é a mesma que se obtém refazendo exatamente esse torneio, com pesos e janela
+	_, _, _, curve := repeatTournament(strategies, cfg, 1)
+	if again := playTournament(strategies, cfg).cooperationCurve(); !reflect.DeepEqual(curve, again) {
+		t.Errorf("curva do torneio %v difere da do mesmo torneio refeito %v", curve, again)
+	}
+
+	// TFT contra TFT coopera sempre; TFT contra Always Defect só na primeira rodada
+	_, _, _, curve = repeatTournament([]Strategy{TitForTat{}, AlwaysDefect{}}, TournamentConfig{Rounds: 5, Seed: 1}, 2)
+	want := []float64{0.5, 0.25, 0.25, 0.25, 0.25}
+	if !reflect.DeepEqual(curve, want) {
+		t.Errorf("curva TFT e Always Defect = %v, esperado %v", curve, want)
+	}
+}
+
+func TestStartingScoresAreAddedToEarnedPoints(t *testing.T) {
+	m := defaultPayoff
+	game := NewGame(AlwaysDefect{}, AlwaysCooperate{}, 5)
+	game.SetStartingScores(10, 30)
+	if game.scores != [2]int{10, 30} {
+		t.Fatalf("pontuação antes da primeira rodada %v, esperado a inicial", game.scores)
+	}
+	for round := 0; round < 5; round++ {
+		game.PlayRound(round)
+	}
+	if want := [2]int{10 + 5*m.Temptation, 30 + 5*m.Sucker}; game.scores != want {
+		t.Errorf("pontuação final %v, esperado %v (inicial mais os pontos ganhos)", game.scores, want)
+	}
+
+	// A média das duas ordens considera só os pontos ganhos, não a pontuação inicial
+	averageA, averageB := swappedAverage(game, playSwapped(game))
+	if averageA != float64(5*m.Temptation) || averageB != float64(5*m.Sucker) {
+		t.Errorf("médias das duas ordens (%.1f, %.1f), esperado (%d, %d)", averageA, averageB, 5*m.Temptation, 5*m.Sucker)
+	}
+}
+
+func TestPlaySwappedKeepsTheMatchSettings(t *testing.T) {
+	game := NewGame(&Joss{}, &Random{}, 50)
+	game.SetPayoff(PayoffMatrix{Reward: 4, Sucker: 0, Temptation: 6, Punishment: 2})
+	game.SetCooperationBonus(1)
+	game.SetDefectionEscalation(1)
+	game.SetHistoryWindow(3)
+	game.SetStartingScores(7, 2)
+	game.SeedStrategies(5)
+	race, err := game.RunUntilScore(60, 50)
+	if err != nil || !race.reached {
+		t.Fatalf("o jogo não chegou ao alvo: %+v, %v", race, err)
+	}
+
+	swapped := playSwapped(game)
+	if swapped.payoff != game.payoff || swapped.coopBonus != game.coopBonus || swapped.defectEscalation != game.defectEscalation ||
+		swapped.historyWindow != game.historyWindow || swapped.handicap != [2]int{2, 7} {
+		t.Errorf("configuração do jogo trocado difere da original: %+v", swapped)
+	}
+	if len(swapped.movesA) != len(game.movesA) {
+		t.Errorf("jogo trocado com %d rodadas, o original parou em %d", len(swapped.movesA), len(game.movesA))
+	}
+	// Random ignora o oponente: com a mesma semente, joga igual nas duas posições
+	if movesString(swapped.movesA) != movesString(game.movesB) {
+		t.Errorf("Random jogou diferente na outra posição:\n%s\n%s", movesString(swapped.movesA), movesString(game.movesB))
+	}
+	// Trocar de novo reproduz o jogo original
+	again := playSwapped(swapped)
+	if movesString(again.movesA) != movesString(game.movesA) || again.scores != game.scores {
+		t.Errorf("trocar duas vezes deu %v, esperado o jogo original %v", again.scores, game.scores)
+	}
+}
+
+func TestExtremePairingsOnFixedMatrix(t *testing.T) {
+	names := []string{"A", "B", "C"}
+	// Pontos de cada linha contra cada coluna; entre estratégias diferentes, somando os dois jogos
+	matrix := [][]int{
+		{20, 24, 2},
+		{24, 20, 0},
+		{22, 6, 4},
+	}
+	cooperative, hostile, ok := extremePairings(matrix, names, []int{1, 1, 1})
+	if !ok {
+		t.Fatal("nenhum confronto encontrado")
+	}
+	if want := (Pairing{nameA: "A", nameB: "B", combined: 24}); cooperative != want {
+		t.Errorf("confronto mais cooperativo: %+v, esperado %+v", cooperative, want)
+	}
+	if want := (Pairing{nameA: "B", nameB: "C", combined: 3}); hostile != want {
+		t.Errorf("confronto mais hostil: %+v, esperado %+v", hostile, want)
+	}
+
+	// Estratégias sem cópias não entram; sem nenhuma, não há confronto
+	if _, hostile, _ := extremePairings(matrix, names, []int{1, 1, 0}); hostile.nameB == "C" {
+		t.Errorf("confronto mais hostil com C, que não jogou: %+v", hostile)
+	}
+	if _, _, ok := extremePairings(matrix, names, []int{0, 0, 0}); ok {
+		t.Error("confronto encontrado num torneio sem jogos")
+	}
+}
+
+func TestDominatedStrategiesOnFixedMatrix(t *testing.T) {
+	names := []string{"A", "B", "C"}
+	matrix := [][]int{
+		{10, 4, 6},
+		{10, 8, 6},
+		{12, 2, 6},
+	}
+	tests := []struct {
+		name   string
+		matrix [][]int
+		counts []int
+		want   []Dominance
+	}{
+		// B faz pelo menos o que A faz contra todos e mais contra B; C ganha de um e perde do outro
+		{"B domina A", matrix, []int{1, 1, 1}, []Dominance{{dominated: "A", by: "B"}}},
+		// Sem B, só os jogos contra A e C contam, e neles C faz pelo menos o que A faz
+		{"sem B, C domina A", matrix, []int{1, 0, 1}, []Dominance{{dominated: "A", by: "C"}}},
+		{"empate em tudo não é dominância", [][]int{{4, 4}, {4, 4}}, []int{1, 1}, nil},
+	}
+	for _, tt := range tests {
+		if got := dominatedStrategies(tt.matrix, names[:len(tt.matrix)], tt.counts); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: %v, esperado %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEmptyAndSingleStrategyTournaments(t *testing.T) {
+	cfg := TournamentConfig{Rounds: 10, Seed: 4}
+
+	// Sem estratégias: nenhum confronto e resultados vazios
+	checkpoint := playTournament(nil, cfg)
+	if checkpoint.Completed != 0 || len(checkpoint.TotalScores) != 0 {
+		t.Errorf("torneio vazio: %d confrontos, totais %v", checkpoint.Completed, checkpoint.TotalScores)
+	}
+	results, matrix, samples, curve := repeatTournament(nil, cfg, 3)
+	if len(results) != 0 || len(matrix) != 0 || len(samples) != 0 || len(curve) != 0 {
+		t.Errorf("torneio vazio repetido: %v, %v, %v, %v", results, matrix, samples, curve)
+	}
+
+	// Uma estratégia só joga contra si mesma, e o jogo conta para os dois lados
+	strategies := []Strategy{TitForTat{}}
+	checkpoint = playTournament(strategies, cfg)
+	if want := 2 * 10 * defaultPayoff.Reward; checkpoint.Completed != 1 || checkpoint.TotalScores["Tit-for-Tat"] != want {
+		t.Errorf("torneio de uma estratégia: %d confrontos e %d pontos, esperado 1 e %d",
+			checkpoint.Completed, checkpoint.TotalScores["Tit-for-Tat"], want)
+	}
+	results, matrix, samples, curve = repeatTournament(strategies, cfg, 2)
+	want := 2 * 10 * defaultPayoff.Reward
+	if len(results) != 1 || results[0].score != want || results[0].rank != 1 || results[0].games != 2 {
+		t.Errorf("resultados repetidos: %+v", results)
+	}
+	if !reflect.DeepEqual(matrix, [][]int{{want}}) {
+		t.Errorf("matriz %v, esperado [[%d]]", matrix, want)
+	}
+	if !reflect.DeepEqual(samples["Tit-for-Tat"], []float64{float64(want), float64(want)}) {
+		t.Errorf("amostras %v, esperado duas de %d", samples["Tit-for-Tat"], want)
+	}
+	if len(curve) != 10 || curve[0] != 1 || curve[9] != 1 {
+		t.Errorf("curva de cooperação %v, esperado 10 rodadas de cooperação total", curve)
+	}
+}
+
+func TestComputeAndNormalizeMetrics(t *testing.T) {
+	metrics := computeMetrics([]Strategy{TitForTat{}, AlwaysDefect{}, Alternator{}}, 10)
+	// Tit-for-Tat: 70 contra si mesma, 9 contra Always Defect e 47 contra Alternator
+	want := []StrategyMetrics{
+		{name: "Tit-for-Tat", score: 42, coopRate: 17.0 / 30, niceness: 1, retaliation: 1, forgiveness: 1},
+		{name: "Always Defect", score: 28, coopRate: 0, niceness: 0, retaliation: 1, forgiveness: 0},
+	}
+	for i, w := range want {
+		got := metrics[i]
+		if got.name != w.name {
+			t.Fatalf("métrica %d de %s, esperado %s", i, got.name, w.name)
+		}
+		for k, v := range got.values() {
+			if math.Abs(v-w.values()[k]) > 1e-9 {
+				t.Errorf("%s, %s: %.4f, esperado %.4f", w.name, metricAxes()[k], v, w.values()[k])
+			}
+		}
+	}
+
+	// Tit-for-Tat é a melhor em todos os eixos; Always Defect só a iguala na retaliação
+	normalized := normalizeMetrics(metrics)
+	wantNormalized := [][]float64{{1, 1, 1, 1, 1}, {28.0 / 42, 0, 0, 1, 0}}
+	for i, w := range wantNormalized {
+		for k := range w {
+			if math.Abs(normalized[i][k]-w[k]) > 1e-9 {
+				t.Errorf("%s normalizada, %s: %.4f, esperado %.4f", metrics[i].name, metricAxes()[k], normalized[i][k], w[k])
+			}
+		}
+	}
+	// Eixos sem valores positivos ficam em 0
+	if got := normalizeMetrics([]StrategyMetrics{{name: "Zero"}}); !reflect.DeepEqual(got, [][]float64{{0, 0, 0, 0, 0}}) {
+		t.Errorf("métricas zeradas normalizadas: %v", got)
+	}
+}
+
+func TestDeviationFromTFT(t *testing.T) {
+	tests := []struct {
+		name      string
+		own, opp  string
+		want      float64
+		deviation int
+	}{
+		{"Tit-for-Tat não se desvia", "CDDC", "DDCD", 0, 0},
+		{"trair na primeira rodada é um desvio", "DDDC", "DDCD", 0.25, 1},
+		{"Always Defect contra quem coopera", "DDDD", "CCCC", 1, 4},
+		{"Alternator contra Always Defect", "CDCD", "DDDD", 0.25, 1},
+		{"histórico vazio", "", "", 0, 0},
+	}
+	for _, tt := range tests {
+		own, opp := parseMoves(tt.own), parseMoves(tt.opp)
+		if got := tftDeviations(own, opp); got != tt.deviation {
+			t.Errorf("%s: %d desvios, esperado %d", tt.name, got, tt.deviation)
+		}
+		if got := deviationFromTFT(own, opp); got != tt.want {
+			t.Errorf("%s: desvio %.2f, esperado %.2f", tt.name, got, tt.want)
+		}
+	}
+}
+
+// callOrder trai quando é consultada antes do oponente na rodada e coopera quando é consultada
+// depois; as duas instâncias de um jogo compartilham o contador de consultas
+type callOrder struct {
+	name  string
+	calls *int
+}
+
+func (s callOrder) NextMove(int, []Choice) Choice {
+	*s.calls++
+	if *s.calls%2 == 1 {
+		return Defect
+	}
+	return Cooperate
+}
+func (s callOrder) Name() string { return s.name }
+
+func TestSwappedAverageRemovesPositionEffect(t *testing.T) {
+	calls := 0
+	const rounds = 20
+	game := playMatch(t, callOrder{"Leader", &calls}, callOrder{"Follower", &calls}, rounds, 1)
+	// Numa ordem só, a estratégia na posição A explora a outra em todas as rodadas
+	if want := [2]int{rounds * defaultPayoff.Temptation, rounds * defaultPayoff.Sucker}; game.scores != want {
+		t.Fatalf("placar numa ordem: %v, esperado %v", game.scores, want)
+	}
+	averageA, averageB := swappedAverage(game, playSwapped(game))
+	want := float64(rounds*(defaultPayoff.Temptation+defaultPayoff.Sucker)) / 2
+	if averageA != want || averageB != want {
+		t.Errorf("média das duas ordens: %.1f e %.1f, esperado %.1f para as duas", averageA, averageB, want)
+	}
+	if averageA == float64(game.scores[0]) {
+		t.Error("a média das duas ordens é igual ao resultado de uma ordem só")
+	}
+}
+
+func TestConfidenceInterval95(t *testing.T) {
+	tests := []struct {
+		name         string
+		samples      []float64
+		mean, margin float64
+	}{
+		// Variância amostral 32/7: a margem é 1,96·√(32/7/8)
+		{"amostras conhecidas", []float64{2, 4, 4, 4, 5, 5, 7, 9}, 5, 1.96 * math.Sqrt(4.0/7)},
+		{"amostras iguais", []float64{3, 3, 3}, 3, 0},
+		{"nenhuma amostra", nil, 0, 0},
+		{"uma amostra", []float64{42}, 42, 0},
+	}
+	for _, tt := range tests {
+		mean, margin := confidenceInterval95(tt.samples)
+		if math.Abs(mean-tt.mean) > 1e-9 || math.Abs(margin-tt.margin) > 1e-9 {
+			t.Errorf("%s: média %.4f ± %.4f, esperado %.4f ± %.4f", tt.name, mean, margin, tt.mean, tt.margin)
+		}
+	}
+}
+
+func TestDiffTournaments(t *testing.T) {
+	a := []Result{
+		{name: "Tit-for-Tat", rank: 1, score: 300},
+		{name: "Grudger", rank: 2, score: 280},
+		{name: "Always Defect", rank: 3, score: 250},
+		{name: "Random", rank: 4, score: 200},
+		{name: "Pavlov", rank: 5, score: 150},
+	}
+	b := []Result{
+		{name: "Always Defect", rank: 1, score: 320},
+		{name: "Tit-for-Tat", rank: 2, score: 310},
+		{name: "Random", rank: 3, score: 210},
+		{name: "Grudger", rank: 4, score: 190},
+		{name: "Alternator", rank: 5, score: 100},
+	}
+	// Always Defect e Grudger mudaram 2 posições (empate pela posição em B), Tit-for-Tat e Random
+	// mudaram 1; Alternator (só em B) e Pavlov (só em A) vêm no fim, em ordem alfabética
+	want := []RankDelta{
+		{name: "Always Defect", rankA: 3, rankB: 1, scoreA: 250, scoreB: 320},
+		{name: "Grudger", rankA: 2, rankB: 4, scoreA: 280, scoreB: 190},
+		{name: "Tit-for-Tat", rankA: 1, rankB: 2, scoreA: 300, scoreB: 310},
+		{name: "Random", rankA: 4, rankB: 3, scoreA: 200, scoreB: 210},
+		{name: "Alternator", rankB: 5, scoreB: 100},
+		{name: "Pavlov", rankA: 5, scoreA: 150},
+	}
+	got := diffTournaments(a, b)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("diferenças %+v, esperado %+v", got, want)
+	}
+	for i, moved := range []int{2, -2, -1, 1, 0, 0} {
+		if got[i].moved() != moved {
+			t.Errorf("%s subiu %d posições, esperado %d", got[i].name, got[i].moved(), moved)
+		}
+	}
+}
+
+func TestHistoryWindowChangesMajorityDecision(t *testing.T) {
+	// O oponente trai 5 vezes e depois só coopera: com todo o histórico a maioria ainda é de
+	// traições até a décima rodada, mas com uma janela de 3 as traições antigas somem da conta
+	play := func(window int) string {
+		game := NewGame(NewWeightedMajority(1), scripted("DDDDD"), 10)
+		game.SetHistoryWindow(window)
+		for round := 0; round < 10; round++ {
+			if err := game.PlayRound(round); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return movesString(game.movesA)
+	}
+	if got := play(0); got != "CDDDDDDDDD" {
+		t.Errorf("com todo o histórico jogou %s, esperado CDDDDDDDDD", got)
+	}
+	if got := play(3); got != "CDDDDDDCCC" {
+		t.Errorf("com janela de 3 jogou %s, esperado CDDDDDDCCC", got)
+	}
+}
+
+func TestMatchCards(t *testing.T) {
+	// Tit-for-Tat contra um oponente que trai nas rodadas 0, 1 e 3: TFT CDDCDC, oponente DDCDCC
+	game := playMatch(t, TitForTat{}, scripted("DDCD"), 6, 1)
+	want := [2]StrategyCard{
+		{name: "Tit-for-Tat", description: tr("desc_tit_for_tat"), coopRate: 0.5,
+			score: 0 + 1 + 10 + 0 + 10 + 7, exploited: 2, exploiter: 2},
+		{name: "Scripted", description: tr("no_description"), coopRate: 0.5,
+			score: 10 + 1 + 0 + 10 + 0 + 7, exploited: 2, exploiter: 2},
+	}
+	if got := matchCards(game); got != want {
+		t.Errorf("cartões %+v, esperado %+v", got, want)
+	}
+
+	// Quem sempre coopera só é explorado; o oponente só explora
+	game = playMatch(t, AlwaysCooperate{}, scripted("DDCD"), 6, 1)
+	cards := matchCards(game)
+	if cards[0].exploited != 3 || cards[0].exploiter != 0 || cards[1].exploited != 0 || cards[1].exploiter != 3 {
+		t.Errorf("explorações %+v", cards)
+	}
+	if cards[0].coopRate != 1 || cards[1].coopRate != 0.5 || cards[0].score != 21 || cards[1].score != 51 {
+		t.Errorf("cooperação e pontuação %+v", cards)
+	}
+}
+
+func TestWindowedCorrelation(t *testing.T) {
+	// Sem variação não há correlação definida
+	game := playMatch(t, AlwaysCooperate{}, AlwaysCooperate{}, 20, 1)
+	for i, c := range windowedCorrelation(game.movesA, game.movesB, correlationWindow) {
+		if !math.IsNaN(c) {
+			t.Errorf("rodada %d: correlação %v entre dois Always Cooperate, esperado NaN", i, c)
+		}
+	}
+
+	// Jogadores que se alternam em fase oposta estão perfeitamente anticorrelacionados; a primeira
+	// rodada sozinha é indefinida
+	alternating := parseMoves("CDCDCDCDCDCD")
+	opposite := parseMoves("DCDCDCDCDCDC")
+	correlations := windowedCorrelation(alternating, opposite, correlationWindow)
+	if !math.IsNaN(correlations[0]) {
+		t.Errorf("rodada 0: correlação %v, esperado NaN", correlations[0])
+	}
+	for i, c := range correlations[1:] {
+		if math.Abs(c+1) > 1e-9 {
+			t.Errorf("rodada %d: correlação %v em fase oposta, esperado -1", i+1, c)
+		}
+	}
+	// Na mesma fase, +1
+	for i, c := range windowedCorrelation(alternating, alternating, correlationWindow)[1:] {
+		if math.Abs(c-1) > 1e-9 {
+			t.Errorf("rodada %d: correlação %v na mesma fase, esperado 1", i+1, c)
+		}
+	}
+
+	// A janela esquece o passado: com 3 rodadas de cooperação mútua, nada varia dentro dela
+	correlations = windowedCorrelation(parseMoves("CDCCC"), parseMoves("DCCCC"), 3)
+	if !math.IsNaN(correlations[4]) || math.IsNaN(correlations[2]) || correlations[2] >= 0 {
+		t.Errorf("correlações com janela de 3: %v", correlations)
+	}
+}
+
+func TestBuildDominanceGraph(t *testing.T) {
+	names := []string{"A", "B", "C"}
+	// A tira 30 de B a mais do que cede, B tira 10 de C a mais, e A e C empatam
+	matrix := [][]int{
+		{100, 50, 60},
+		{20, 100, 40},
+		{60, 30, 100},
+	}
+	if got, want := buildDominanceGraph(matrix, names, 0), [][2]int{{0, 1}, {1, 2}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("arestas com limiar 0: %v, esperado %v", got, want)
+	}
+	// A diferença precisa superar o limiar: 10 não supera 10
+	if got, want := buildDominanceGraph(matrix, names, 10), [][2]int{{0, 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("arestas com limiar 10: %v, esperado %v", got, want)
+	}
+	if got := buildDominanceGraph(matrix, names, 30); len(got) != 0 {
+		t.Errorf("arestas com limiar 30: %v, esperado nenhuma", got)
+	}
+	if got := buildDominanceGraph(nil, nil, 0); len(got) != 0 {
+		t.Errorf("arestas sem estratégias: %v", got)
+	}
+}
+
+func TestIsEvolutionarilyStable(t *testing.T) {
+	// Em 10 rodadas: E(AD,AD) = 10 > E(TFT,AD) = 9, e E(TFT,TFT) = 70 > E(AD,TFT) = 19, então as
+	// duas populações resistem à invasão da outra
+	strategies := []Strategy{AlwaysDefect{}, TitForTat{}}
+	for _, target := range []string{"Always Defect", "Tit-for-Tat"} {
+		if !isEvolutionarilyStable(strategies, 10, 1, target) {
+			t.Errorf("%s deveria ser estável", target)
+		}
+	}
+
+	// Always Cooperate é invadida por Always Defect (E(AD,AC) = 100 > 70)
+	strategies = []Strategy{AlwaysCooperate{}, AlwaysDefect{}}
+	if isEvolutionarilyStable(strategies, 10, 1, "Always Cooperate") {
+		t.Error("Always Cooperate não deveria ser estável contra Always Defect")
+	}
+	// Contra Always Cooperate, Tit-for-Tat empata nas duas condições (70 = 70): o mutante se
+	// espalha por deriva, e ela não é estável
+	strategies = []Strategy{TitForTat{}, AlwaysCooperate{}}
+	if isEvolutionarilyStable(strategies, 10, 1, "Tit-for-Tat") {
+		t.Error("Tit-for-Tat não deveria ser estável contra Always Cooperate")
+	}
+	if isEvolutionarilyStable(strategies, 10, 1, "Grofman") {
+		t.Error("estratégia ausente considerada estável")
+	}
+}
+
+func TestQualityAdjustedScoresReorderRanking(t *testing.T) {
+	// A faz mais pontos no total (120 contra 110), mas a maior parte contra C, a mais fraca; B
+	// pontua bem contra A. Ponderando pela força dos oponentes, B passa à frente
+	names := []string{"A", "B", "C"}
+	matrix := [][]int{
+		{10, 10, 100},
+		{50, 10, 50},
+		{0, 10, 0},
+	}
+	scores := qualityAdjustedScores(matrix, names)
+	if !(scores["B"] > scores["A"] && scores["A"] > scores["C"]) {
+		t.Errorf("pontuações ajustadas %v, esperado B > A > C", scores)
+	}
+
+	// Estratégias que pontuam igual contra todos continuam empatadas
+	scores = qualityAdjustedScores([][]int{{5, 5}, {5, 5}}, []string{"X", "Y"})
+	if math.Abs(scores["X"]-scores["Y"]) > 1e-9 || scores["X"] <= 0 {
+		t.Errorf("pontuações ajustadas simétricas %v", scores)
+	}
+	// Sem pontos, nada a ponderar
+	scores = qualityAdjustedScores([][]int{{0, 0}, {0, 0}}, []string{"X", "Y"})
+	if scores["X"] != 0 || scores["Y"] != 0 {
+		t.Errorf("pontuações ajustadas sem pontos %v", scores)
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Aparência do gráfico de barras
+const (
+	barChartMinWidth = 400
+	barRowHeight     = 24  // Altura de cada barra, incluindo o espaçamento
+	barLabelWidth    = 180 // Espaço reservado para os nomes à esquerda das barras
+	barCapHeight     = 8   // Altura das marcas nas pontas das barras de erro
+)
+
+// barColor é a cor das barras; as barras de erro usam a cor do texto do tema
+var barColor = color.RGBA{R: 0x1f, G: 0x77, B: 0xb4, A: 0xff}
+
+// Bar é uma barra horizontal do gráfico, com uma margem de erro opcional (0 = sem barra de erro)
+type Bar struct {
+	label  string
+	value  float64
+	margin float64
+}
+
+// BarChart é um gráfico de barras horizontais com barras de erro, uma barra por linha
+type BarChart struct {
+	widget.BaseWidget
+	bars []Bar
+}
+
+// NewBarChart cria um gráfico de barras vazio
+func NewBarChart() *BarChart {
+	chart := &BarChart{}
+	chart.ExtendBaseWidget(chart)
+	return chart
+}
+
+// SetBars substitui as barras exibidas e redesenha o gráfico
+func (c *BarChart) SetBars(bars []Bar) {
+	c.bars = bars
+	c.Refresh()
+}
+
+func (c *BarChart) CreateRenderer() fyne.WidgetRenderer {
+	return &barChartRenderer{chart: c}
+}
+
+// barChartRenderer recria os retângulos, linhas e textos a cada mudança de tamanho ou de dados
+type barChartRenderer struct {
+	chart   *BarChart
+	objects []fyne.CanvasObject
+}
+
+func (r *barChartRenderer) Layout(size fyne.Size) {
+	r.objects = r.objects[:0]
+	width := size.Width - barLabelWidth
+	if width <= 0 || len(r.chart.bars) == 0 {
+		return
+	}
+
+	// A escala vai até a maior ponta de barra de erro, para que todas caibam
+	maxValue := 0.0
+	for _, bar := range r.chart.bars {
+		maxValue = math.Max(maxValue, bar.value+bar.margin)
+	}
+	if maxValue <= 0 {
+		maxValue = 1
+	}
+	x := func(value float64) float32 {
+		return barLabelWidth + width*float32(math.Max(0, value)/maxValue)
+	}
+
+	foreground := theme.Color(theme.ColorNameForeground)
+	line := func(from, to fyne.Position) {
+		l := canvas.NewLine(foreground)
+		l.StrokeWidth = 1
+		l.Position1, l.Position2 = from, to
+		r.objects = append(r.objects, l)
+	}
+	for i, bar := range r.chart.bars {
+		top := float32(i * barRowHeight)
+		label := canvas.NewText(bar.label, foreground)
+		label.TextSize = theme.CaptionTextSize()
+		label.Move(fyne.NewPos(0, top+4))
+		r.objects = append(r.objects, label)
+
+		rect := canvas.NewRectangle(barColor)
+		rect.Move(fyne.NewPos(barLabelWidth, top+4))
+		rect.Resize(fyne.NewSize(x(bar.value)-barLabelWidth, barRowHeight-8))
+		r.objects = append(r.objects, rect)
+
+		// Barra de erro: de value-margin a value+margin, com marcas nas pontas
+		if bar.margin > 0 {
+			middle := top + barRowHeight/2
+			low, high := x(bar.value-bar.margin), x(bar.value+bar.margin)
+			line(fyne.NewPos(low, middle), fyne.NewPos(high, middle))
+			line(fyne.NewPos(low, middle-barCapHeight/2), fyne.NewPos(low, middle+barCapHeight/2))
+			line(fyne.NewPos(high, middle-barCapHeight/2), fyne.NewPos(high, middle+barCapHeight/2))
+		}
+	}
+}
+
+func (r *barChartRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(barChartMinWidth, float32(len(r.chart.bars)*barRowHeight))
+}
+
+func (r *barChartRenderer) Refresh() {
+	r.Layout(r.chart.Size())
+	canvas.Refresh(r.chart)
+}
+
+func (r *barChartRenderer) Objects() []fyne.CanvasObject { return r.objects }
+func (r *barChartRenderer) Destroy()                     {}
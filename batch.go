@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// runBatch joga todos os confrontos entre as estratégias, reps vezes cada, e escreve
+// uma linha CSV por (estratégia A, estratégia B, repetição) com pontuações e taxas de cooperação
+func runBatch(strategies []Strategy, rounds, reps int, seed int64, w io.Writer) error {
+	writer := csv.NewWriter(w)
+	header := []string{"estrategia_a", "estrategia_b", "repeticao", "pontuacao_a", "pontuacao_b", "cooperacao_a", "cooperacao_b"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	// Cada jogo recebe geradores próprios derivados da semente, para que o lote inteiro seja
+	// reproduzível sem alterar o rng global
+	match := int64(0)
+	for _, stratA := range strategies {
+		for _, stratB := range strategies {
+			for rep := 0; rep < reps; rep++ {
+				game := NewGame(freshInstance(stratA), freshInstance(stratB), rounds)
+				game.SeedStrategies(seed + match)
+				match++
+				for round := 0; round < rounds; round++ {
+					if game.PlayRound(round) != nil {
+						break
+					}
+				}
+
+				row := []string{
+					stratA.Name(),
+					stratB.Name(),
+					strconv.Itoa(rep + 1),
+					strconv.Itoa(game.scores[0]),
+					strconv.Itoa(game.scores[1]),
+					strconv.FormatFloat(cooperationRate(game.movesA), 'f', 4, 64),
+					strconv.FormatFloat(cooperationRate(game.movesB), 'f', 4, 64),
+				}
+				if err := writer.Write(row); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// Parâmetros do autoteste de reprodutibilidade
+const (
+	selfTestRounds = 100 // Rodadas de cada confronto
+	selfTestSeed   = 42  // Semente usada pelo botão da tela inicial
+)
+
+// selfTestDeterminism joga duas vezes o torneio entre as estratégias registradas com a mesma
+// semente (diferente de zero) e verifica se as saídas são idênticas byte a byte
+func selfTestDeterminism(seed int64) error {
+	return checkDeterminism(newStrategies, seed)
+}
+
+// checkDeterminism joga duas vezes, pelo mesmo caminho de um torneio semeado da interface, o torneio
+// entre as estratégias criadas por newFn e compara as saídas. O rng global não é ressemeado: ele
+// continua a sua sequência de uma execução para a outra, então uma estratégia que sorteie com ele
+// em vez do próprio gerador (veja Game.SeedStrategies) dá resultados diferentes e é detectada
+func checkDeterminism(newFn func() []Strategy, seed int64) error {
+	var outputs [2]bytes.Buffer
+	for run := range outputs {
+		results, matrix, _, curve := repeatTournament(newFn(), TournamentConfig{Rounds: selfTestRounds, Seed: seed}, 1)
+		if err := writeTournamentOutput(results, matrix, curve, &outputs[run]); err != nil {
+			return err
+		}
+	}
+	if bytes.Equal(outputs[0].Bytes(), outputs[1].Bytes()) {
+		return nil
+	}
+	first, second := bytes.Split(outputs[0].Bytes(), []byte("\n")), bytes.Split(outputs[1].Bytes(), []byte("\n"))
+	for i := 0; i < len(first) && i < len(second); i++ {
+		if !bytes.Equal(first[i], second[i]) {
+			return fmt.Errorf(tr("err_self_test"), first[i], second[i])
+		}
+	}
+	return fmt.Errorf(tr("err_self_test"), outputs[0].String(), outputs[1].String())
+}
+
+// writeTournamentOutput escreve em CSV o resultado de um torneio: uma linha por estratégia, as
+// linhas da matriz de confrontos e a curva de cooperação por rodada
+func writeTournamentOutput(results []Result, matrix [][]int, curve []float64, w io.Writer) error {
+	writer := csv.NewWriter(w)
+	for _, result := range results {
+		row := []string{
+			strconv.Itoa(result.rank),
+			result.name,
+			strconv.Itoa(result.score),
+			strconv.FormatFloat(result.coopRate, 'f', -1, 64),
+			strconv.FormatFloat(result.deviation, 'f', -1, 64),
+			strconv.FormatBool(result.nice),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	for _, line := range matrix {
+		row := make([]string, len(line))
+		for i, score := range line {
+			row[i] = strconv.Itoa(score)
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	row := make([]string, len(curve))
+	for i, rate := range curve {
+		row[i] = strconv.FormatFloat(rate, 'f', -1, 64)
+	}
+	if err := writer.Write(row); err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+)
+
+// globalRandom coopera ou trai ao acaso sorteando com o rng global, e não com o gerador do jogo
+type globalRandom struct{}
+
+func (globalRandom) NextMove(int, []Choice) Choice {
+	if rng.Intn(2) == 0 {
+		return Cooperate
+	}
+	return Defect
+}
+func (globalRandom) Name() string { return "Global Random" }
+
+func TestSelfTestDeterminism(t *testing.T) {
+	if err := selfTestDeterminism(selfTestSeed); err != nil {
+		t.Fatalf("o autoteste falhou com as estratégias registradas: %v", err)
+	}
+
+	// Uma estratégia que sorteia com o rng global não se repete entre as duas execuções
+	withGlobal := func() []Strategy { return []Strategy{TitForTat{}, &Joss{}, globalRandom{}} }
+	if err := checkDeterminism(withGlobal, selfTestSeed); err == nil {
+		t.Error("o autoteste não detectou a estratégia que sorteia com o rng global")
+	}
+}
+
+func TestRunBatchWritesOneRowPerMatchAndRepetition(t *testing.T) {
+	forbidGlobalRNG(t)
+	strategies := []Strategy{TitForTat{}, AlwaysDefect{}, &Random{}}
+	const rounds, reps = 10, 4
+	var buf bytes.Buffer
+	if err := runBatch(strategies, rounds, reps, 7, &buf); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := len(strategies)
+	if len(rows) != 1+n*n*reps {
+		t.Fatalf("%d linhas, esperado %d (cabeçalho mais %d×%d×%d)", len(rows), 1+n*n*reps, n, n, reps)
+	}
+	if rows[0][0] != "estrategia_a" {
+		t.Errorf("a primeira linha não é o cabeçalho: %v", rows[0])
+	}
+	// Tit-for-Tat contra Always Defect: coopera só na primeira rodada
+	for _, row := range rows[1:] {
+		if row[0] == "Tit-for-Tat" && row[1] == "Always Defect" && row[5] != "0.1000" {
+			t.Errorf("cooperação de Tit-for-Tat contra Always Defect: %s, esperado 0.1000", row[5])
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+// StrategyCategory agrupa as estratégias na interface. Cada estratégia pertence a uma categoria
+// de cada eixo: amável ou agressiva, determinística ou estocástica, reativa ou independente
+type StrategyCategory int
+
+const (
+	CategoryNice          StrategyCategory = iota // Nunca trai primeiro
+	CategoryNasty                                 // Trai primeiro contra algum oponente
+	CategoryDeterministic                         // Joga sempre igual diante do mesmo histórico
+	CategoryStochastic                            // Sorteia jogadas
+	CategoryReactive                              // Joga diferente conforme o oponente
+	CategoryIndependent                           // Ignora o oponente
+)
+
+// categoryLabels descreve as categorias, na ordem das constantes, para a interface
+func categoryLabels() []string {
+	return []string{
+		tr("category_nice"), tr("category_nasty"),
+		tr("category_deterministic"), tr("category_stochastic"),
+		tr("category_reactive"), tr("category_independent"),
+	}
+}
+
+// categoryProbeRounds é a duração dos jogos de sondagem usados para classificar uma estratégia
+const categoryProbeRounds = 100
+
+// probeMoves joga s contra opponent por categoryProbeRounds rodadas, com os geradores semeados
+// por seed, e retorna as jogadas dos dois
+func probeMoves(s, opponent Strategy, seed int64) (own, other []Choice) {
+	game := NewGame(freshInstance(s), freshInstance(opponent), categoryProbeRounds)
+	game.seedSides(seed, seed+1)
+	for round := 0; round < categoryProbeRounds; round++ {
+		if game.PlayRound(round) != nil {
+			break
+		}
+	}
+	return game.movesA, game.movesB
+}
+
+// sameMoves indica se as duas sequências de jogadas são iguais
+func sameMoves(a, b []Choice) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// strategyCategories deduz as categorias de s pelo comportamento em jogos de sondagem: é agressiva
+// se trair primeiro (ou junto) contra algum oponente de referência, com alguma das sementes,
+// estocástica se mudar de jogadas quando só a semente muda e reativa se jogar diferente contra
+// Always Cooperate e Always Defect
+func strategyCategories(s Strategy) []StrategyCategory {
+	categories := make([]StrategyCategory, 0, 3)
+
+	nice := true
+	for _, opponent := range []Strategy{AlwaysCooperate{}, AlwaysDefect{}, TitForTat{}, Alternator{}, &Random{}} {
+		for _, seed := range []int64{1, 2} {
+			own, other := probeMoves(s, opponent, seed)
+			first, otherFirst := firstDefection(own), firstDefection(other)
+			if first >= 0 && (otherFirst < 0 || first <= otherFirst) {
+				nice = false
+			}
+		}
+	}
+	if nice {
+		categories = append(categories, CategoryNice)
+	} else {
+		categories = append(categories, CategoryNasty)
+	}
+
+	stochastic := false
+	for _, opponent := range []Strategy{TitForTat{}, Alternator{}} {
+		first, _ := probeMoves(s, opponent, 1)
+		second, _ := probeMoves(s, opponent, 2)
+		if !sameMoves(first, second) {
+			stochastic = true
+		}
+	}
+	if stochastic {
+		categories = append(categories, CategoryStochastic)
+	} else {
+		categories = append(categories, CategoryDeterministic)
+	}
+
+	againstCooperator, _ := probeMoves(s, AlwaysCooperate{}, 1)
+	againstDefector, _ := probeMoves(s, AlwaysDefect{}, 1)
+	if sameMoves(againstCooperator, againstDefector) {
+		categories = append(categories, CategoryIndependent)
+	} else {
+		categories = append(categories, CategoryReactive)
+	}
+	return categories
+}
+
+// strategiesInCategory retorna os nomes das estratégias que pertencem à categoria, na ordem dada
+func strategiesInCategory(strategies []Strategy, category StrategyCategory) []string {
+	var names []string
+	for _, s := range strategies {
+		for _, c := range strategyCategories(s) {
+			if c == category {
+				names = append(names, s.Name())
+				break
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEveryStrategyHasOneCategoryPerAxis(t *testing.T) {
+	axes := [][2]StrategyCategory{
+		{CategoryNice, CategoryNasty},
+		{CategoryDeterministic, CategoryStochastic},
+		{CategoryReactive, CategoryIndependent},
+	}
+	labels := categoryLabels()
+	for _, s := range newStrategies() {
+		categories := strategyCategories(s)
+		if len(categories) != len(axes) {
+			t.Errorf("%s: categorias %v, esperado uma de cada eixo", s.Name(), categories)
+			continue
+		}
+		for i, c := range categories {
+			if c != axes[i][0] && c != axes[i][1] {
+				t.Errorf("%s: categoria %d fora do eixo %v", s.Name(), c, axes[i])
+			}
+			if int(c) >= len(labels) || labels[c] == "" {
+				t.Errorf("%s: categoria %d sem rótulo", s.Name(), c)
+			}
+		}
+	}
+}
+
+func TestStrategiesInCategory(t *testing.T) {
+	strategies := []Strategy{TitForTat{}, AlwaysDefect{}, AlwaysCooperate{}, &Random{}, Grofman{}}
+	tests := []struct {
+		category StrategyCategory
+		want     []string
+	}{
+		{CategoryNice, []string{"Tit-for-Tat", "Always Cooperate"}},
+		{CategoryNasty, []string{"Always Defect", "Random", "Grofman"}},
+		{CategoryDeterministic, []string{"Tit-for-Tat", "Always Defect", "Always Cooperate", "Grofman"}},
+		{CategoryStochastic, []string{"Random"}},
+		{CategoryReactive, []string{"Tit-for-Tat"}},
+		{CategoryIndependent, []string{"Always Defect", "Always Cooperate", "Random", "Grofman"}},
+	}
+	for _, tt := range tests {
+		if got := strategiesInCategory(strategies, tt.category); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("categoria %q: %v, esperado %v", categoryLabels()[tt.category], got, tt.want)
+		}
+	}
+	if got := strategiesInCategory(nil, CategoryNice); len(got) != 0 {
+		t.Errorf("categoria de uma lista vazia: %v", got)
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// TournamentCheckpoint guarda o progresso de um torneio "todos contra todos": quantos confrontos
+// já foram disputados e os totais acumulados até ali. Com Config.Seed diferente de zero, cada
+// confronto é ressemeado a partir da semente, então retomar produz os mesmos totais de uma
+// execução ininterrupta
+type TournamentCheckpoint struct {
+	Config         TournamentConfig
+	Strategies     []string       // Nomes das estratégias, na ordem da matriz
+	Completed      int            // Confrontos já disputados, na ordem de tournamentPairings
+	TotalScores    map[string]int // Pontuação total de cada estratégia
+	Matrix         [][]int        // Matriz de confrontos acumulada
+	Cooperations   map[string]int // Jogadas cooperativas de cada estratégia (reputação)
+	Moves          map[string]int // Jogadas de cada estratégia (reputação)
+	Deviations     map[string]int // Jogadas de cada estratégia que diferiram da previsão de Tit-for-Tat
+	FirstDefectors [][]int        // FirstDefectors[i][j]: jogos contra j em que i traiu primeiro (ou junto)
+	Forfeits       map[string]int // Jogos em que cada estratégia foi desclassificada por entrar em pânico
+
+	// RoundCooperations[r] e RoundMoves[r] somam, em todos os jogos, as jogadas cooperativas e as
+	// jogadas disputadas na rodada r (jogos interrompidos por pânico têm menos rodadas)
+	RoundCooperations []int
+	RoundMoves        []int
+
+	learners map[int]Strategy // Instâncias persistentes dos aprendizes, por índice da estratégia
+}
+
+// newTournamentCheckpoint cria o estado inicial de um torneio, sem nenhum confronto disputado
+func newTournamentCheckpoint(strategies []Strategy, cfg TournamentConfig) *TournamentCheckpoint {
+	names := make([]string, len(strategies))
+	matrix := make([][]int, len(strategies))
+	firstDefectors := make([][]int, len(strategies))
+	for i, s := range strategies {
+		names[i] = s.Name()
+		matrix[i] = make([]int, len(strategies))
+		firstDefectors[i] = make([]int, len(strategies))
+	}
+	reputation := NewReputation()
+	return &TournamentCheckpoint{
+		Config:         cfg,
+		Strategies:     names,
+		TotalScores:    make(map[string]int),
+		Matrix:         matrix,
+		Cooperations:   reputation.cooperations,
+		Moves:          reputation.moves,
+		Deviations:     make(map[string]int),
+		FirstDefectors: firstDefectors,
+		Forfeits:       make(map[string]int),
+
+		RoundCooperations: make([]int, max(0, cfg.Rounds)),
+		RoundMoves:        make([]int, max(0, cfg.Rounds)),
+	}
+}
+
+// reputation expõe a reputação acumulada no checkpoint; registrar nela atualiza o checkpoint
+func (c *TournamentCheckpoint) reputation() *Reputation {
+	return &Reputation{cooperations: c.Cooperations, moves: c.Moves}
+}
+
+// saveCheckpoint grava o progresso do torneio em JSON no arquivo path
+func saveCheckpoint(path string, c *TournamentCheckpoint) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// loadCheckpoint lê um checkpoint gravado por saveCheckpoint
+func loadCheckpoint(path string) (*TournamentCheckpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c TournamentCheckpoint
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	if c.TotalScores == nil {
+		c.TotalScores = make(map[string]int)
+	}
+	if c.Cooperations == nil {
+		c.Cooperations = make(map[string]int)
+	}
+	if c.Moves == nil {
+		c.Moves = make(map[string]int)
+	}
+	if c.Deviations == nil {
+		c.Deviations = make(map[string]int)
+	}
+	if c.Forfeits == nil {
+		c.Forfeits = make(map[string]int)
+	}
+	// Checkpoints antigos não registravam quem traiu primeiro em cada confronto: os jogos já
+	// disputados não contam para saber quem é amável
+	if len(c.FirstDefectors) != len(c.Strategies) {
+		c.FirstDefectors = make([][]int, len(c.Strategies))
+		for i := range c.FirstDefectors {
+			c.FirstDefectors[i] = make([]int, len(c.Strategies))
+		}
+	}
+	// Checkpoints antigos não tinham a cooperação por rodada: a curva cobre só o que falta jogar
+	if len(c.RoundMoves) != c.Config.Rounds || len(c.RoundCooperations) != c.Config.Rounds {
+		c.RoundCooperations = make([]int, max(0, c.Config.Rounds))
+		c.RoundMoves = make([]int, max(0, c.Config.Rounds))
+	}
+	return &c, nil
+}
+
+// resumeTournament retoma o torneio salvo em path, disputando os confrontos restantes com as
+// estratégias dadas, que devem ser as mesmas (e na mesma ordem) do torneio original
+func resumeTournament(path string, strategies []Strategy) ([]Result, [][]int, error) {
+	c, err := loadCheckpoint(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(c.Strategies) != len(strategies) || len(c.Matrix) != len(strategies) {
+		return nil, nil, fmt.Errorf("checkpoint com %d estratégias, mas %d foram informadas", len(c.Strategies), len(strategies))
+	}
+	for i, s := range strategies {
+		if c.Strategies[i] != s.Name() {
+			return nil, nil, fmt.Errorf("estratégia %d do checkpoint é %q, mas foi informada %q", i, c.Strategies[i], s.Name())
+		}
+	}
+	c.advance(strategies, -1)
+	results, matrix := c.results(strategies)
+	return results, matrix, nil
+}
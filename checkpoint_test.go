@@ -0,0 +1,42 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestResumedTournamentMatchesUninterrupted(t *testing.T) {
+	forbidGlobalRNG(t)
+	newFn := func() []Strategy {
+		return []Strategy{TitForTat{}, &Random{}, &Joss{}, AlwaysDefect{}, &FrequencyModeler{}}
+	}
+	cfg := TournamentConfig{Rounds: 30, Seed: 5}
+	wantResults, wantMatrix := playTournament(newFn(), cfg).results(newFn())
+
+	// Interrompe o torneio no meio, grava o checkpoint e retoma com novas instâncias
+	strategies := newFn()
+	checkpoint := newTournamentCheckpoint(strategies, cfg)
+	if checkpoint.advance(strategies, 7) {
+		t.Fatal("o torneio terminou antes da interrupção")
+	}
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	if err := saveCheckpoint(path, checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	results, matrix, err := resumeTournament(path, newFn())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("retomado:\n%+v\nsem interrupção:\n%+v", results, wantResults)
+	}
+	if !reflect.DeepEqual(matrix, wantMatrix) {
+		t.Errorf("matriz retomada %v, sem interrupção %v", matrix, wantMatrix)
+	}
+
+	// Retomar com outras estratégias é um erro
+	if _, _, err := resumeTournament(path, []Strategy{TitForTat{}}); err == nil {
+		t.Error("retomou o checkpoint com outras estratégias")
+	}
+}
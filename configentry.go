@@ -0,0 +1,30 @@
+package main
+
+import "fyne.io/fyne/v2/widget"
+
+// configEntry é um campo de texto que avisa quando a edição termina (Enter ou perda de foco), para
+// que a configuração seja gravada uma vez por edição, e não a cada tecla
+type configEntry struct {
+	widget.Entry
+	onCommit func() // Chamada ao fim de cada edição (nil = nada a fazer)
+}
+
+// newConfigEntry cria um campo vazio, sem ação ao fim da edição
+func newConfigEntry() *configEntry {
+	entry := &configEntry{}
+	entry.ExtendBaseWidget(entry)
+	entry.OnSubmitted = func(string) { entry.commit() }
+	return entry
+}
+
+func (e *configEntry) FocusLost() {
+	e.Entry.FocusLost()
+	e.commit()
+}
+
+// commit avisa que a edição terminou
+func (e *configEntry) commit() {
+	if e.onCommit != nil {
+		e.onCommit()
+	}
+}
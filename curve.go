@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CurvePoint é um ponto de controle da curva: a probabilidade de trair na rodada Round
+type CurvePoint struct {
+	Round   int
+	PDefect float64
+}
+
+// CurveStrategy: Trai com a probabilidade dada por uma curva definida pelo usuário, interpolando
+// linearmente entre os pontos de controle (uma generalização da rampa linear de Feld)
+type CurveStrategy struct {
+	randomized
+	points []CurvePoint // Ordenados por rodada
+}
+
+// NewCurveStrategy cria a estratégia com os pontos de controle dados, em qualquer ordem
+func NewCurveStrategy(points []CurvePoint) *CurveStrategy {
+	sorted := append([]CurvePoint(nil), points...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Round < sorted[j].Round })
+	return &CurveStrategy{points: sorted}
+}
+
+// defectProbability interpola a probabilidade de trair na rodada dada; antes do primeiro ponto
+// e depois do último, vale a probabilidade do ponto mais próximo
+func (s *CurveStrategy) defectProbability(round int) float64 {
+	if len(s.points) == 0 {
+		return 0
+	}
+	if round <= s.points[0].Round {
+		return s.points[0].PDefect
+	}
+	for i := 1; i < len(s.points); i++ {
+		a, b := s.points[i-1], s.points[i]
+		if round <= b.Round {
+			t := float64(round-a.Round) / float64(b.Round-a.Round)
+			return a.PDefect + t*(b.PDefect-a.PDefect)
+		}
+	}
+	return s.points[len(s.points)-1].PDefect
+}
+
+func (s *CurveStrategy) NextMove(round int, opponentMoves []Choice) Choice {
+	if s.random().Float64() < s.defectProbability(round) {
+		return Defect
+	}
+	return Cooperate
+}
+func (s *CurveStrategy) Name() string {
+	return fmt.Sprintf(tr("curve_name"), formatCurvePoints(s.points))
+}
+func (s *CurveStrategy) Clone() Strategy { return NewCurveStrategy(s.points) }
+
+// formatCurvePoints codifica os pontos como "rodada:probabilidade", separados por vírgula
+func formatCurvePoints(points []CurvePoint) string {
+	parts := make([]string, len(points))
+	for i, p := range points {
+		parts[i] = strconv.Itoa(p.Round) + ":" + strconv.FormatFloat(p.PDefect, 'f', -1, 64)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// parseCurvePoints lê pontos no formato de formatCurvePoints, validando que as rodadas não são
+// negativas nem repetidas e que as probabilidades estão entre 0 e 1
+func parseCurvePoints(text string) ([]CurvePoint, error) {
+	var points []CurvePoint
+	seen := make(map[int]bool)
+	for _, field := range strings.Split(text, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		roundText, pText, ok := strings.Cut(field, ":")
+		round, errRound := strconv.Atoi(strings.TrimSpace(roundText))
+		p, errP := strconv.ParseFloat(strings.TrimSpace(pText), 64)
+		if !ok || errRound != nil || errP != nil || round < 0 {
+			return nil, fmt.Errorf(tr("err_curve_point"), field)
+		}
+		if p < 0 || p > 1 {
+			return nil, fmt.Errorf(tr("err_curve_range"), field)
+		}
+		if seen[round] {
+			return nil, fmt.Errorf(tr("err_curve_repeated"), round)
+		}
+		seen[round] = true
+		points = append(points, CurvePoint{Round: round, PDefect: p})
+	}
+	if len(points) == 0 {
+		return nil, fmt.Errorf(tr("err_curve_point"), text)
+	}
+	return points, nil
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCurveInterpolatesBetweenPoints(t *testing.T) {
+	// Fora de ordem de propósito: o construtor ordena os pontos pela rodada
+	s := NewCurveStrategy([]CurvePoint{{Round: 100, PDefect: 0.9}, {Round: 0, PDefect: 0.1}, {Round: 50, PDefect: 0.5}})
+	tests := []struct {
+		round int
+		want  float64
+	}{
+		{-5, 0.1},  // antes do primeiro ponto
+		{0, 0.1},   // no ponto
+		{25, 0.3},  // ponto médio do primeiro segmento
+		{50, 0.5},  // no ponto do meio
+		{75, 0.7},  // ponto médio do segundo segmento
+		{60, 0.58}, // um quinto do segundo segmento
+		{100, 0.9}, // no último ponto
+		{500, 0.9}, // depois do último ponto
+	}
+	for _, tt := range tests {
+		if got := s.defectProbability(tt.round); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("rodada %d: %.4f, esperado %.4f", tt.round, got, tt.want)
+		}
+	}
+	// Sem pontos, nunca trai
+	if got := NewCurveStrategy(nil).defectProbability(10); got != 0 {
+		t.Errorf("curva vazia: %.2f, esperado 0", got)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// LogLevel controla o quanto de cada jogo é registrado no log de depuração
+type LogLevel int
+
+const (
+	LogNone  LogLevel = iota // Nada é registrado
+	LogMoves                 // Jogadas e pontuações de cada rodada
+	LogState                 // Também o estado interno das estratégias que implementam StateLogger
+)
+
+// parseLogLevel converte o nome de um nível de log ("none", "moves" ou "state") em LogLevel
+func parseLogLevel(name string) (LogLevel, error) {
+	switch name {
+	case "none", "":
+		return LogNone, nil
+	case "moves":
+		return LogMoves, nil
+	case "state":
+		return LogState, nil
+	}
+	return LogNone, fmt.Errorf("nível de log desconhecido: %q (use none, moves ou state)", name)
+}
+
+// StateLogger é implementada por estratégias que expõem seu estado interno para depuração
+type StateLogger interface {
+	State() string
+}
+
+// strategyState retorna o estado interno da estratégia, ou "" se ela não o expuser
+func strategyState(s Strategy) string {
+	if l, ok := s.(StateLogger); ok {
+		return l.State()
+	}
+	return ""
+}
+
+// roundLogEntry é uma linha (JSON) do log de depuração, gravada após cada rodada
+type roundLogEntry struct {
+	Round     int    `json:"rodada"`
+	StrategyA string `json:"estrategia_a"`
+	StrategyB string `json:"estrategia_b"`
+	MoveA     string `json:"jogada_a"`
+	MoveB     string `json:"jogada_b"`
+	ScoreA    int    `json:"pontuacao_a"`
+	ScoreB    int    `json:"pontuacao_b"`
+	StateA    string `json:"estado_a,omitempty"`
+	StateB    string `json:"estado_b,omitempty"`
+}
+
+// moveCode retorna a jogada como "C" (cooperar) ou "D" (trair), para logs e arquivos
+func moveCode(move Choice) string {
+	if move == Cooperate {
+		return "C"
+	}
+	return "D"
+}
+
+// SetLog faz o jogo registrar cada rodada em w, com o detalhamento de level
+func (g *Game) SetLog(w io.Writer, level LogLevel) {
+	g.log, g.logLevel = w, level
+}
+
+// logRound registra a última rodada jogada, se o log estiver ativo
+func (g *Game) logRound(round int) {
+	if g.log == nil || g.logLevel == LogNone {
+		return
+	}
+	entry := roundLogEntry{
+		Round:     round + 1,
+		StrategyA: g.strategyA.Name(),
+		StrategyB: g.strategyB.Name(),
+		MoveA:     moveCode(g.movesA[len(g.movesA)-1]),
+		MoveB:     moveCode(g.movesB[len(g.movesB)-1]),
+		ScoreA:    g.scores[0],
+		ScoreB:    g.scores[1],
+	}
+	if g.logLevel >= LogState {
+		entry.StateA, entry.StateB = strategyState(g.strategyA), strategyState(g.strategyB)
+	}
+	// O log é só para depuração: uma falha de escrita não deve interromper o jogo
+	_ = json.NewEncoder(g.log).Encode(entry)
+}
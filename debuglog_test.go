@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+// logEntries joga Shubik contra o roteiro dado com o log no nível level e decodifica as linhas
+func logEntries(t *testing.T, script string, level LogLevel) []roundLogEntry {
+	t.Helper()
+	var buf bytes.Buffer
+	game := NewGame(&Shubik{}, scripted(script), len(script))
+	game.SetLog(&buf, level)
+	for round := range script {
+		if err := game.PlayRound(round); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var entries []roundLogEntry
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var entry roundLogEntry
+		if err := decoder.Decode(&entry); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestShubikStateIsLogged(t *testing.T) {
+	// Depois da traição na rodada 3, Shubik pune por duas rodadas: o contador marca a punição restante
+	entries := logEntries(t, "CCDCCC", LogState)
+	wantMoves := "CCCDDC"
+	wantStates := []string{"defectCount=0", "defectCount=0", "defectCount=0", "defectCount=1", "defectCount=0", "defectCount=0"}
+	if len(entries) != len(wantStates) {
+		t.Fatalf("%d linhas no log, esperado %d", len(entries), len(wantStates))
+	}
+	for i, entry := range entries {
+		if entry.Round != i+1 || entry.MoveA != wantMoves[i:i+1] || entry.StateA != wantStates[i] {
+			t.Errorf("linha %d: rodada %d, jogada %s, estado %q; esperado rodada %d, jogada %c, estado %q",
+				i, entry.Round, entry.MoveA, entry.StateA, i+1, wantMoves[i], wantStates[i])
+		}
+		// O roteiro não expõe estado
+		if entry.StateB != "" {
+			t.Errorf("linha %d: estado de B %q, esperado vazio", i, entry.StateB)
+		}
+	}
+
+	// No nível das jogadas, o estado não é registrado
+	for i, entry := range logEntries(t, "CCDCCC", LogMoves) {
+		if entry.StateA != "" {
+			t.Errorf("nível das jogadas, linha %d: estado %q registrado", i, entry.StateA)
+		}
+	}
+}
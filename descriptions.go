@@ -0,0 +1,74 @@
+package main
+
+// Describer é implementada por estratégias que têm uma descrição curta para iniciantes
+type Describer interface {
+	Description() string
+}
+
+// strategyDescription retorna a descrição da estratégia, ou uma mensagem padrão se ela não tiver uma
+func strategyDescription(s Strategy) string {
+	if d, ok := s.(Describer); ok {
+		return d.Description()
+	}
+	return tr("no_description")
+}
+
+// Descrições das estratégias, traduzidas pelo catálogo de mensagens
+func (s TitForTat) Description() string               { return tr("desc_tit_for_tat") }
+func (s Random) Description() string                  { return tr("desc_random") }
+func (s TidemanChieruzzi) Description() string        { return tr("desc_tideman") }
+func (s Nydegger) Description() string                { return tr("desc_nydegger") }
+func (s Grofman) Description() string                 { return tr("desc_grofman") }
+func (s *Shubik) Description() string                 { return tr("desc_shubik") }
+func (s SteinRapoport) Description() string           { return tr("desc_stein_rapoport") }
+func (s *Friedman) Description() string               { return tr("desc_friedman") }
+func (s Davis) Description() string                   { return tr("desc_davis") }
+func (s Graaskamp) Description() string               { return tr("desc_graaskamp") }
+func (s *Downing) Description() string                { return tr("desc_downing") }
+func (s Feld) Description() string                    { return tr("desc_feld") }
+func (s Joss) Description() string                    { return tr("desc_joss") }
+func (s Tullock) Description() string                 { return tr("desc_tullock") }
+func (s NameWithheld) Description() string            { return tr("desc_name_withheld") }
+func (s Alternator) Description() string              { return tr("desc_alternator") }
+func (s AlwaysCooperate) Description() string         { return tr("desc_always_cooperate") }
+func (s AlwaysDefect) Description() string            { return tr("desc_always_defect") }
+func (s *Phased) Description() string                 { return tr("desc_phased") }
+func (s *FrequencyModeler) Description() string       { return tr("desc_frequency_modeler") }
+func (s *ForgivingPavlov) Description() string        { return tr("desc_forgiving_pavlov") }
+func (s LaggedTitForTat) Description() string         { return tr("desc_lagged_tit_for_tat") }
+func (s *ProportionalRetaliator) Description() string { return tr("desc_proportional_retaliator") }
+func (s *ReputationStrategy) Description() string     { return tr("desc_reputation") }
+func (s *Reflective) Description() string             { return tr("desc_reflective") }
+func (s *FairnessEnforcer) Description() string       { return tr("desc_fairness_enforcer") }
+func (s *Endgamer) Description() string               { return tr("desc_endgamer") }
+func (s *PeaceOffering) Description() string          { return tr("desc_peace_offering") }
+func (s *MemoryOne) Description() string              { return tr("desc_memory_one") }
+func (s *HumanStrategy) Description() string          { return tr("desc_human") }
+func (s *CurveStrategy) Description() string          { return tr("desc_curve") }
+func (s *WeightedMajority) Description() string       { return tr("desc_weighted_majority") }
+func (s *Negotiator) Description() string             { return tr("desc_negotiator") }
+func (s *MetaLearner) Description() string            { return tr("desc_meta_learner") }
+func (s *CatchUp) Description() string                { return tr("desc_catch_up") }
+func (s *QLearner) Description() string               { return tr("desc_q_learner") }
+func (s *StimulusResponse) Description() string       { return tr("desc_stimulus_response") }
+func (s *LoopExploiter) Description() string          { return tr("desc_loop_exploiter") }
+func (s *MixedStrategy) Description() string          { return tr("desc_mixed") }
+func (s *Apologizer) Description() string             { return tr("desc_apologizer") }
+func (s *Hardener) Description() string               { return tr("desc_hardener") }
+func (s *Maximin) Description() string                { return tr("desc_maximin") }
+func (s PrimeCooperator) Description() string         { return tr("desc_prime_cooperator") }
+func (s *Chameleon) Description() string              { return tr("desc_chameleon") }
+func (s *Annealer) Description() string               { return tr("desc_annealer") }
+func (s *NiceRetaliator) Description() string         { return tr("desc_nice_retaliator") }
+func (s *NeuralStrategy) Description() string         { return tr("desc_neural") }
+func (s TimeHealer) Description() string              { return tr("desc_time_healer") }
+func (s *ThresholdProber) Description() string        { return tr("desc_threshold_prober") }
+func (s *SelfRegulator) Description() string          { return tr("desc_self_regulator") }
+func (s PhaseMatcher) Description() string            { return tr("desc_phase_matcher") }
+func (s *MinimaxRegret) Description() string          { return tr("desc_minimax_regret") }
+func (s *BayesianPlayer) Description() string         { return tr("desc_bayesian") }
+func (s *FriedmanAmnesty) Description() string        { return tr("desc_friedman_amnesty") }
+func (s *EscalatingPunisher) Description() string     { return tr("desc_escalating_punisher") }
+func (s DeterministicChaos) Description() string      { return tr("desc_deterministic_chaos") }
+func (s *RatioTargeter) Description() string          { return tr("desc_ratio_targeter") }
+func (s *OccasionalTester) Description() string       { return tr("desc_occasional_tester") }
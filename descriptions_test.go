@@ -0,0 +1,20 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEveryRegisteredStrategyHasDescription(t *testing.T) {
+	defer func(previous string) { language = previous }(language)
+	for _, lang := range languages {
+		language = lang
+		for _, s := range newStrategies() {
+			description := strategyDescription(s)
+			// tr devolve a própria chave quando ela não existe no catálogo
+			if strings.TrimSpace(description) == "" || description == tr("no_description") || strings.HasPrefix(description, "desc_") {
+				t.Errorf("%s: %s sem descrição (%q)", lang, s.Name(), description)
+			}
+		}
+	}
+}
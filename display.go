@@ -0,0 +1,78 @@
+package main
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+)
+
+// displayModePreferenceKey é a chave das preferências onde o modo de exibição das jogadas fica salvo
+const displayModePreferenceKey = "modoExibicao"
+
+// DisplayMode é a forma de mostrar as jogadas na interface
+type DisplayMode int
+
+const (
+	DisplayEmoji   DisplayMode = iota // ✅ e ❌
+	DisplayLetters                    // C e D
+	DisplayColor                      // Só a cor de fundo da célula, verde ou vermelha, sem texto
+)
+
+// Cores de fundo das jogadas no modo só cor
+var (
+	cooperateColor = color.RGBA{R: 0x2c, G: 0xa0, B: 0x2c, A: 0xff}
+	defectColor    = color.RGBA{R: 0xd6, G: 0x27, B: 0x28, A: 0xff}
+)
+
+// displayMode é o modo de exibição das jogadas em uso
+var displayMode = DisplayEmoji
+
+// displayModeLabels descreve os modos, na ordem das constantes, para a interface
+func displayModeLabels() []string {
+	return []string{tr("display_emoji"), tr("display_letters"), tr("display_color")}
+}
+
+// renderMove converte a jogada no texto exibido no modo dado; no modo só cor a célula fica sem
+// texto e a jogada aparece na cor de fundo (veja moveColor)
+func renderMove(m Choice, mode DisplayMode) string {
+	switch mode {
+	case DisplayLetters:
+		return moveCode(m)
+	case DisplayColor:
+		return ""
+	}
+	if m == Cooperate {
+		return "✅"
+	}
+	return "❌"
+}
+
+// moveColor retorna a cor de fundo da célula da jogada: verde ou vermelha no modo só cor e
+// transparente nos outros
+func moveColor(m Choice, mode DisplayMode) color.Color {
+	if mode != DisplayColor {
+		return color.Transparent
+	}
+	if m == Cooperate {
+		return cooperateColor
+	}
+	return defectColor
+}
+
+// moveText converte a jogada no texto exibido fora das tabelas, onde não há fundo para colorir:
+// no modo só cor, usa as letras
+func moveText(m Choice, mode DisplayMode) string {
+	if mode == DisplayColor {
+		mode = DisplayLetters
+	}
+	return renderMove(m, mode)
+}
+
+// setDisplayMode troca o modo de exibição das jogadas e salva a escolha nas preferências
+func setDisplayMode(a fyne.App, mode DisplayMode) {
+	if mode < DisplayEmoji || mode > DisplayColor {
+		mode = DisplayEmoji
+	}
+	displayMode = mode
+	a.Preferences().SetInt(displayModePreferenceKey, int(mode))
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestRenderMove(t *testing.T) {
+	cases := []struct {
+		mode                                  DisplayMode
+		cooperate, defect                     string
+		cooperateBackground, defectBackground color.Color
+		cooperateText, defectText             string
+	}{
+		{DisplayEmoji, "✅", "❌", color.Transparent, color.Transparent, "✅", "❌"},
+		{DisplayLetters, "C", "D", color.Transparent, color.Transparent, "C", "D"},
+		// Só cor: a célula fica sem texto e só o fundo mostra a jogada; fora das tabelas, as letras
+		{DisplayColor, "", "", cooperateColor, defectColor, "C", "D"},
+	}
+	for _, c := range cases {
+		if got := renderMove(Cooperate, c.mode); got != c.cooperate {
+			t.Errorf("modo %d, cooperação: %q, esperado %q", c.mode, got, c.cooperate)
+		}
+		if got := renderMove(Defect, c.mode); got != c.defect {
+			t.Errorf("modo %d, traição: %q, esperado %q", c.mode, got, c.defect)
+		}
+		if got := moveColor(Cooperate, c.mode); got != c.cooperateBackground {
+			t.Errorf("modo %d, fundo da cooperação: %v, esperado %v", c.mode, got, c.cooperateBackground)
+		}
+		if got := moveColor(Defect, c.mode); got != c.defectBackground {
+			t.Errorf("modo %d, fundo da traição: %v, esperado %v", c.mode, got, c.defectBackground)
+		}
+		if got := moveText(Cooperate, c.mode); got != c.cooperateText {
+			t.Errorf("modo %d, texto da cooperação: %q, esperado %q", c.mode, got, c.cooperateText)
+		}
+		if got := moveText(Defect, c.mode); got != c.defectText {
+			t.Errorf("modo %d, texto da traição: %q, esperado %q", c.mode, got, c.defectText)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+// GauntletMatch é o resultado de um dos jogos de um desafio em sequência ("gauntlet")
+type GauntletMatch struct {
+	opponent                 string
+	heroScore, opponentScore int
+	forfeited                bool // Algum dos dois entrou em pânico e o jogo foi interrompido
+}
+
+// runGauntlet faz hero jogar contra cada oponente, na ordem dada, um jogo de rounds rodadas por
+// oponente. A reputação é compartilhada ao longo da sequência: as estratégias que a consultam
+// (veja ReputationAware) sabem como o herói se comportou contra os oponentes anteriores, como se
+// os oponentes trocassem informações entre si
+func runGauntlet(hero Strategy, opponents []Strategy, rounds int, reputation *Reputation) []GauntletMatch {
+	matches := make([]GauntletMatch, 0, len(opponents))
+	for _, opponent := range opponents {
+		heroInstance, opponentInstance := freshInstance(hero), freshInstance(opponent)
+		game := NewGame(heroInstance, opponentInstance, rounds)
+		informReputation(heroInstance, reputation, opponent.Name())
+		informReputation(opponentInstance, reputation, hero.Name())
+		for round := 0; round < rounds; round++ {
+			if game.PlayRound(round) != nil {
+				break
+			}
+		}
+		reputation.Record(hero.Name(), game.movesA)
+		reputation.Record(opponent.Name(), game.movesB)
+		matches = append(matches, GauntletMatch{
+			opponent:      opponent.Name(),
+			heroScore:     game.scores[0],
+			opponentScore: game.scores[1],
+			forfeited:     game.err != nil,
+		})
+	}
+	return matches
+}
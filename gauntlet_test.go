@@ -0,0 +1,35 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGauntletCarriesReputation(t *testing.T) {
+	// O herói que trai explora o primeiro oponente; a reputação chega ao segundo, que trai desde a
+	// primeira rodada
+	reputation := NewReputation()
+	matches := runGauntlet(AlwaysDefect{}, []Strategy{AlwaysCooperate{}, &ReputationStrategy{threshold: 0.5}}, 10, reputation)
+	want := []GauntletMatch{
+		{opponent: "Always Cooperate", heroScore: 10 * defaultPayoff.Temptation, opponentScore: 10 * defaultPayoff.Sucker},
+		{opponent: "Reputation", heroScore: 10 * defaultPayoff.Punishment, opponentScore: 10 * defaultPayoff.Punishment},
+	}
+	if !reflect.DeepEqual(matches, want) {
+		t.Errorf("jogos do herói que trai: %+v, esperado %+v", matches, want)
+	}
+	if score, known := reputation.Score("Always Defect"); !known || score != 0 {
+		t.Errorf("reputação do herói %.2f (conhecida: %v), esperado 0", score, known)
+	}
+
+	// Quem cooperou antes é recebido com cooperação
+	matches = runGauntlet(TitForTat{}, []Strategy{AlwaysCooperate{}, &ReputationStrategy{threshold: 0.5}}, 10, NewReputation())
+	if got := matches[1]; got.heroScore != 10*defaultPayoff.Reward || got.opponentScore != 10*defaultPayoff.Reward {
+		t.Errorf("jogo contra Reputation do herói que coopera: %+v", got)
+	}
+
+	// Sem reputação anterior, o herói que trai ainda é recebido com cooperação
+	matches = runGauntlet(AlwaysDefect{}, []Strategy{&ReputationStrategy{threshold: 0.5}}, 10, NewReputation())
+	if got := matches[0]; got.heroScore != 10*defaultPayoff.Temptation {
+		t.Errorf("primeiro jogo contra Reputation: %+v, esperado a cooperação do oponente", got)
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+)
+
+// Dimensões e limites do GIF do gráfico de pontuação
+const (
+	gifWidth     = 480
+	gifHeight    = 320
+	gifMargin    = 20
+	maxGIFFrames = 100 // Jogos longos são subamostrados para limitar o tamanho do arquivo
+	gifDelay     = 5   // Intervalo entre quadros, em centésimos de segundo
+)
+
+// Paleta do GIF: fundo, eixos, estratégia A e estratégia B
+var gifPalette = color.Palette{
+	color.White,
+	color.Black,
+	color.RGBA{R: 0x1f, G: 0x77, B: 0xb4, A: 0xff},
+	color.RGBA{R: 0xd6, G: 0x27, B: 0x28, A: 0xff},
+}
+
+// scoreChartGIF gera um GIF animado do gráfico de pontuação acumulada das duas estratégias, em
+// que cada quadro acrescenta mais rodadas; scoresA e scoresB são as pontuações acumuladas por rodada
+func scoreChartGIF(scoresA, scoresB []int) *gif.GIF {
+	anim := &gif.GIF{}
+	rounds := len(scoresA)
+	if rounds == 0 {
+		return anim
+	}
+
+	maxScore := 1
+	for i := 0; i < rounds; i++ {
+		maxScore = max(maxScore, max(scoresA[i], scoresB[i]))
+	}
+	// Converte (rodada, pontuação) em coordenadas da imagem
+	point := func(round, score int) (int, int) {
+		x := gifMargin
+		if rounds > 1 {
+			x += round * (gifWidth - 2*gifMargin) / (rounds - 1)
+		}
+		y := gifHeight - gifMargin - score*(gifHeight-2*gifMargin)/maxScore
+		return x, y
+	}
+
+	frames := min(rounds, maxGIFFrames)
+	for f := 0; f < frames; f++ {
+		// Última rodada exibida neste quadro (o último quadro sempre mostra o jogo inteiro)
+		upTo := (f + 1) * rounds / frames
+		img := image.NewPaletted(image.Rect(0, 0, gifWidth, gifHeight), gifPalette)
+		drawLine(img, gifMargin, gifMargin, gifMargin, gifHeight-gifMargin, 1)
+		drawLine(img, gifMargin, gifHeight-gifMargin, gifWidth-gifMargin, gifHeight-gifMargin, 1)
+		// A primeira rodada é um ponto, para que o primeiro quadro e os jogos de uma rodada não fiquem vazios
+		x, y := point(0, scoresA[0])
+		drawDot(img, x, y, 2)
+		x, y = point(0, scoresB[0])
+		drawDot(img, x, y, 3)
+		for i := 1; i < upTo; i++ {
+			x0, y0 := point(i-1, scoresA[i-1])
+			x1, y1 := point(i, scoresA[i])
+			drawLine(img, x0, y0, x1, y1, 2)
+			x0, y0 = point(i-1, scoresB[i-1])
+			x1, y1 = point(i, scoresB[i])
+			drawLine(img, x0, y0, x1, y1, 3)
+		}
+		anim.Image = append(anim.Image, img)
+		anim.Delay = append(anim.Delay, gifDelay)
+	}
+	return anim
+}
+
+// writeScoreChartGIF escreve o GIF animado do gráfico de pontuação acumulada em w
+func writeScoreChartGIF(w io.Writer, scoresA, scoresB []int) error {
+	return gif.EncodeAll(w, scoreChartGIF(scoresA, scoresB))
+}
+
+// drawLine desenha um segmento de reta com a cor de índice c da paleta (algoritmo de Bresenham)
+func drawLine(img *image.Paletted, x0, y0, x1, y1 int, c uint8) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	err := dx + dy
+	for {
+		img.SetColorIndex(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// drawDot desenha um quadrado de 3×3 pixels centrado em (x, y) com a cor de índice c da paleta
+func drawDot(img *image.Paletted, x, y int, c uint8) {
+	for dy := -1; dy <= 1; dy++ {
+		drawLine(img, x-1, y+dy, x+1, y+dy, c)
+	}
+}
+
+// abs retorna o valor absoluto de um inteiro
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
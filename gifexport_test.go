@@ -0,0 +1,42 @@
+package main
+
+import (
+	"bytes"
+	"image/gif"
+	"testing"
+)
+
+// hasColor informa se algum pixel do quadro tem a cor de índice c da paleta
+func hasColor(frame []uint8, c uint8) bool {
+	for _, pixel := range frame {
+		if pixel == c {
+			return true
+		}
+	}
+	return false
+}
+
+func TestScoreChartGIFDecodesWithOneFramePerRound(t *testing.T) {
+	for _, rounds := range []int{1, 2, 30, maxGIFFrames, 250} {
+		scoresA, scoresB := make([]int, rounds), make([]int, rounds)
+		for i := range scoresA {
+			scoresA[i], scoresB[i] = 3*(i+1), 5*(i+1)
+		}
+		var buf bytes.Buffer
+		if err := writeScoreChartGIF(&buf, scoresA, scoresB); err != nil {
+			t.Fatalf("%d rodadas: %v", rounds, err)
+		}
+		anim, err := gif.DecodeAll(&buf)
+		if err != nil {
+			t.Fatalf("%d rodadas: o GIF não decodifica: %v", rounds, err)
+		}
+		if want := min(rounds, maxGIFFrames); len(anim.Image) != want {
+			t.Errorf("%d rodadas: %d quadros, esperado %d", rounds, len(anim.Image), want)
+		}
+		// Já o primeiro quadro mostra as duas estratégias
+		first := anim.Image[0]
+		if !hasColor(first.Pix, 2) || !hasColor(first.Pix, 3) {
+			t.Errorf("%d rodadas: o primeiro quadro não mostra as duas estratégias", rounds)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Aparência do grafo de dominância
+const (
+	graphMinSize    = 360 // Tamanho mínimo do grafo, em pixels
+	graphLabelSpace = 70  // Espaço reservado para os nomes em volta do círculo
+	graphNodeRadius = 5   // Raio de cada nó
+	graphArrowSize  = 8   // Comprimento das pontas das setas
+)
+
+// DominanceGraph desenha um grafo dirigido com os nós dispostos em círculo e setas de i para j em
+// cada aresta {i, j}
+type DominanceGraph struct {
+	widget.BaseWidget
+	names []string
+	edges [][2]int
+}
+
+// NewDominanceGraph cria um grafo vazio
+func NewDominanceGraph() *DominanceGraph {
+	graph := &DominanceGraph{}
+	graph.ExtendBaseWidget(graph)
+	return graph
+}
+
+// SetGraph substitui os nós e as arestas exibidos e redesenha o grafo
+func (g *DominanceGraph) SetGraph(names []string, edges [][2]int) {
+	g.names, g.edges = names, edges
+	g.Refresh()
+}
+
+func (g *DominanceGraph) CreateRenderer() fyne.WidgetRenderer {
+	return &graphRenderer{graph: g}
+}
+
+// graphRenderer recria os nós, as setas e os nomes a cada mudança de tamanho ou de grafo
+type graphRenderer struct {
+	graph   *DominanceGraph
+	objects []fyne.CanvasObject
+}
+
+func (r *graphRenderer) Layout(size fyne.Size) {
+	r.objects = r.objects[:0]
+	n := len(r.graph.names)
+	radius := float32(math.Min(float64(size.Width), float64(size.Height))/2 - graphLabelSpace)
+	if n == 0 || radius <= 0 {
+		return
+	}
+	center := fyne.NewPos(size.Width/2, size.Height/2)
+
+	// point converte (nó, distância relativa ao raio) em coordenadas; o primeiro nó fica no topo
+	point := func(node int, scale float64) fyne.Position {
+		angle := 2*math.Pi*float64(node)/float64(n) - math.Pi/2
+		return fyne.NewPos(center.X+radius*float32(scale*math.Cos(angle)),
+			center.Y+radius*float32(scale*math.Sin(angle)))
+	}
+	foreground := theme.Color(theme.ColorNameForeground)
+	line := func(from, to fyne.Position) {
+		l := canvas.NewLine(foreground)
+		l.StrokeWidth = 1
+		l.Position1, l.Position2 = from, to
+		r.objects = append(r.objects, l)
+	}
+
+	// Setas: a linha para na borda do nó de destino, com duas pontas abertas a 30 graus
+	for _, edge := range r.graph.edges {
+		from, to := point(edge[0], 1), point(edge[1], 1)
+		dx, dy := float64(to.X-from.X), float64(to.Y-from.Y)
+		length := math.Hypot(dx, dy)
+		if length == 0 {
+			continue
+		}
+		ux, uy := dx/length, dy/length
+		tip := fyne.NewPos(to.X-float32(ux*graphNodeRadius), to.Y-float32(uy*graphNodeRadius))
+		line(from, tip)
+		for _, side := range []float64{-1, 1} {
+			angle := math.Atan2(uy, ux) + math.Pi + side*math.Pi/6
+			line(tip, fyne.NewPos(tip.X+float32(graphArrowSize*math.Cos(angle)),
+				tip.Y+float32(graphArrowSize*math.Sin(angle))))
+		}
+	}
+
+	// Nós e nomes
+	for i, name := range r.graph.names {
+		pos := point(i, 1)
+		node := canvas.NewCircle(theme.Color(theme.ColorNamePrimary))
+		node.Resize(fyne.NewSize(2*graphNodeRadius, 2*graphNodeRadius))
+		node.Move(fyne.NewPos(pos.X-graphNodeRadius, pos.Y-graphNodeRadius))
+		r.objects = append(r.objects, node)
+
+		label := canvas.NewText(name, foreground)
+		label.TextSize = theme.CaptionTextSize()
+		labelSize := label.MinSize()
+		labelPos := point(i, 1.2)
+		label.Move(fyne.NewPos(labelPos.X-labelSize.Width/2, labelPos.Y-labelSize.Height/2))
+		r.objects = append(r.objects, label)
+	}
+}
+
+func (r *graphRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(graphMinSize, graphMinSize)
+}
+
+func (r *graphRenderer) Refresh() {
+	r.Layout(r.graph.Size())
+	canvas.Refresh(r.graph)
+}
+
+func (r *graphRenderer) Objects() []fyne.CanvasObject { return r.objects }
+func (r *graphRenderer) Destroy()                     {}
@@ -0,0 +1,1029 @@
+package main
+
+import "fyne.io/fyne/v2"
+
+// languagePreferenceKey é a chave das preferências onde o idioma escolhido fica salvo
+const languagePreferenceKey = "idioma"
+
+// defaultLanguage é o idioma padrão e também o usado quando falta uma tradução
+const defaultLanguage = "pt-BR"
+
+// languages lista os idiomas disponíveis, na ordem exibida no seletor
+var languages = []string{"pt-BR", "en", "de"}
+
+// language é o idioma atual da interface
+var language = defaultLanguage
+
+// messages é o catálogo de textos da interface, por idioma e chave
+var messages = map[string]map[string]string{
+	"pt-BR": {
+		"app_title":                 "Spieltheorie - Teoria dos Jogos",
+		"welcome":                   "Bem-vindo ao Spieltheorie!",
+		"mode_normal":               "Modo Normal",
+		"mode_tournament":           "Modo Todos Contra Todos",
+		"mode_human":                "Jogar Contra uma Estratégia",
+		"mode_gauntlet":             "Desafio em Sequência",
+		"gauntlet_hero":             "Estratégia desafiante (enfrenta todos os oponentes, um após o outro):",
+		"gauntlet_opponents":        "Oponentes, na ordem em que forem marcados (estratégias que consultam a reputação sabem como o desafiante jogou contra os anteriores):",
+		"gauntlet_start":            "Iniciar Desafio",
+		"gauntlet_line":             "%d. %s %d × %d %s",
+		"gauntlet_forfeit":          "   (jogo interrompido: uma estratégia falhou)",
+		"gauntlet_total":            "Total de %s: %d pontos (%.1f por oponente)",
+		"err_gauntlet_empty":        "Marque ao menos um oponente.",
+		"mode_history":              "Histórico de Partidas",
+		"back":                      "Voltar",
+		"history_empty":             "Nenhuma partida do modo normal registrada ainda.",
+		"history_line":              "%s — %s %d × %d %s (%d rodadas, semente %d)",
+		"history_replay":            "Rejogar",
+		"history_differs":           "Atenção: o resultado difere do registrado (a estratégia pode ter mudado desde então).",
+		"err_history_line":          "histórico inválido na linha %d: %v",
+		"err_history_strategy":      "A estratégia %q não está disponível para rejogar a partida.",
+		"mode_tournament_log":       "Torneios Gravados",
+		"tournament_log_empty":      "Nenhum torneio gravado ainda.",
+		"tournament_log_open":       "Abrir",
+		"tournament_log_matrix":     "Matriz de confrontos (pontos da linha contra a coluna):",
+		"err_tournament_log":        "torneio gravado inválido em %s: %v",
+		"err_tournament_log_matrix": "a matriz não corresponde às estratégias",
+		"dark_theme":                "Tema escuro",
+		"display_mode":              "Jogadas:",
+		"display_emoji":             "Emoji (✅/❌)",
+		"display_letters":           "Letras (C/D)",
+		"display_color":             "Só cor",
+		"language":                  "Idioma:",
+		"load_plugin":               "Carregar estratégia (plugin Go)",
+		"plugin_loaded":             "Estratégia %q carregada e disponível em todos os modos.",
+		"self_test":                 "Autoteste de reprodutibilidade",
+		"self_test_passed":          "Passou: o mesmo torneio, jogado duas vezes com a mesma semente, deu resultados idênticos.",
+		"err_self_test":             "Falhou: o mesmo torneio deu resultados diferentes com a mesma semente (alguma estratégia sorteia fora do próprio gerador):\n%s\n%s",
+		"err_plugin_open":           "Não foi possível abrir o plugin: %v",
+		"err_plugin_version":        "O plugin foi compilado com outra versão do Go ou das dependências; recompile-o com a mesma versão do programa: %v",
+		"err_plugin_symbol":         "O plugin não exporta a função %s.",
+		"err_plugin_signature":      "A função %s do plugin deve ter a assinatura func() any.",
+		"err_plugin_type":           "A estratégia do plugin deve ter os métodos Name() string e NextMove(round int, opponentMoves []int) int.",
+		"err_plugin_duplicate":      "Já existe uma estratégia chamada %q.",
+		"err_strategy_panic":        "A estratégia %s falhou na rodada %d e foi desclassificada: %v",
+
+		"rounds_label":          "Número de Rodadas:",
+		"rounds_placeholder":    "Digite o número de rodadas",
+		"reset_defaults":        "Restaurar Padrões",
+		"undo":                  "Desfazer",
+		"start_game":            "Iniciar Jogo",
+		"err_invalid_rounds":    "Por favor, insira um número de rodadas válido!",
+		"coop_bonus_label":      "Bônus por cooperação mútua consecutiva (pontos × tamanho da sequência, 0 = desativado):",
+		"err_coop_bonus":        "Por favor, insira um bônus válido (inteiro maior ou igual a zero)!",
+		"escalation_label":      "Penalidade por traição mútua consecutiva (pontos a menos × rodadas seguidas além da primeira, 0 = desativada):",
+		"err_escalation":        "Por favor, insira uma penalidade válida (inteiro maior ou igual a zero)!",
+		"payoff_label":          "Matriz de pontuação (R, S, T, P):",
+		"err_payoff":            "Matriz inválida: %q (use quatro inteiros no formato R, S, T, P)",
+		"err_payoff_order":      "Matriz inválida: %q (é preciso que T > R > P > S)",
+		"history_window_label":  "Janela de histórico (rodadas anteriores que as estratégias enxergam, 0 = todas):",
+		"start_score_label":     "Pontuação inicial de A e de B (vantagem no começo do jogo):",
+		"err_history_window":    "Por favor, insira uma janela válida (inteiro maior ou igual a zero)!",
+		"noise_label":           "Ruído (probabilidade de cada jogada ser trocada pela oposta, de 0 a 1):",
+		"err_noise":             "Por favor, insira um ruído válido (número entre 0 e 1)!",
+		"seed_label":            "Semente dos sorteios (0 = uma nova a cada partida):",
+		"tournament_seed_label": "Semente do torneio (0 = nenhuma; com a ordem embaralhada, uma nova a cada torneio):",
+		"err_seed":              "Por favor, insira uma semente válida (número inteiro)!",
+		"target_score_label":    "Pontuação alvo (o jogo termina quando alguém a atinge; as rodadas viram o limite, 0 = desativada):",
+		"err_target_score":      "Por favor, insira uma pontuação alvo válida (inteiro maior ou igual a zero)!",
+		"err_start_score":       "Por favor, insira pontuações iniciais válidas (números inteiros)!",
+		"race_winner":           "%s atingiu %d pontos primeiro, na rodada %d.",
+		"race_tie":              "Os dois atingiram %d pontos na rodada %d, com o mesmo placar: empate.",
+		"race_cap":              "Ninguém atingiu %d pontos em %d rodadas.",
+		"swap_roles":            "Jogar também com os papéis trocados (B contra A) e mostrar a média",
+		"swapped_average":       "Média das duas ordens: %s %.1f pontos, %s %.1f pontos",
+		"matchup_button":        "Estratégia do dia (partida aleatória)",
+		"matchup_seed":          "Partida sorteada com a semente %d",
+
+		"choose_a":                  "Escolha a Estratégia A:",
+		"choose_b":                  "Escolha a Estratégia B:",
+		"phased_option":             "Composta (troca de estratégia)",
+		"phased_label":              "Estratégia Composta (joga a primeira até a rodada de troca, depois a segunda):",
+		"phased_name":               "%s → %s (rodada %d)",
+		"tideman_params":            "Tideman & Chieruzzi — janela de rodadas e limite de traições para perdoar:",
+		"self_regulator_params":     "Self Regulator — fração de cooperações alvo (0 a 1):",
+		"ratio_targeter_params":     "Ratio Targeter — proporção alvo entre a própria pontuação e a do oponente:",
+		"memory_one_option":         "Memória Um (texto)",
+		"memory_one_params":         "Memória Um — P(C|CC)/P(C|CD)/P(C|DC)/P(C|DD)@P(C inicial), ex.: mo:0.9/0.1/0.9/0.1@0.99",
+		"curve_option":              "Curva de traição (pontos)",
+		"curve_params":              "Curva — pontos rodada:probabilidade de trair, separados por vírgula (ex.: 0:0, 100:0.2, 200:1):",
+		"curve_name":                "Curva (%s)",
+		"mixed_option":              "Mistura probabilística",
+		"mixed_params":              "Mistura — a cada rodada joga a primeira com a probabilidade escolhida, senão a segunda:",
+		"mixed_probability":         "Probabilidade da primeira: %.0f%%",
+		"mixed_name":                "%.0f%% %s / %.0f%% %s",
+		"err_mixed_parts":           "Escolha as duas estratégias da mistura!",
+		"neural_option":             "Rede neural (pesos de um arquivo JSON)",
+		"neural_params":             "Rede neural — arquivo JSON com \"oculta\" (linhas de 7 pesos + viés) e \"saida\" (um peso por neurônio oculto + viés):",
+		"neural_load":               "Carregar pesos…",
+		"neural_default":            "Pesos padrão (imitam Tit-for-Tat)",
+		"err_neural_json":           "Pesos da rede inválidos: %v",
+		"err_neural_shape":          "Pesos da rede: \"%s\" deveria ter %d valores, mas tem %d.",
+		"err_curve_point":           "Ponto inválido: %q (esperado rodada:probabilidade)",
+		"err_curve_range":           "Probabilidade fora do intervalo [0, 1]: %q",
+		"err_curve_repeated":        "Rodada repetida na curva: %d",
+		"copy":                      "Copiar",
+		"err_mo_format":             "Formato inválido: %q (esperado mo:pCC/pCD/pDC/pDD@inicial)",
+		"err_mo_range":              "Probabilidade fora do intervalo [0, 1]: %s",
+		"err_tideman_window":        "Por favor, insira uma janela válida para Tideman & Chieruzzi!",
+		"err_tideman_threshold":     "Por favor, insira um limite entre 0 e 1 para Tideman & Chieruzzi!",
+		"err_self_regulator_target": "Por favor, insira um alvo entre 0 e 1 para o Self Regulator!",
+		"err_ratio_targeter":        "Por favor, insira uma proporção alvo maior que zero para o Ratio Targeter!",
+		"err_choose_both":           "Por favor, escolha as duas estratégias!",
+		"err_phased_switch":         "Por favor, insira uma rodada de troca válida para a estratégia composta!",
+		"err_phased_parts":          "Por favor, escolha as duas partes da estratégia composta!",
+		"progress":                  "Progresso:",
+		"live_average":              "Mostrar a média de pontos por rodada ao vivo",
+		"live_average_line":         "%s: %.2f pontos/rodada",
+		"history_label":             "Histórico das Rodadas:",
+		"recap_label":               "Resumo em Texto:",
+		"card_stats":                "Pontuação: %d\nCooperação: %.0f%%\nFoi explorada: %d vezes\nExplorou o oponente: %d vezes",
+		"correlation_label":         "Correlação das jogadas (janela de %d rodadas; 1 = sincronizados, -1 = alternando):",
+		"col_round":                 "Rodada",
+		"col_move_a":                "Jogada A",
+		"col_move_b":                "Jogada B",
+		"col_score_a":               "Pontuação A",
+		"col_score_b":               "Pontuação B",
+		"col_points_a":              "Pontos A (rodada)",
+		"col_points_b":              "Pontos B (rodada)",
+		"search_first_defect_a":     "Primeira traição de A",
+		"search_first_defect_b":     "Primeira traição de B",
+		"search_first_dd":           "Primeiro DD",
+		"search_found":              "Rodada %d",
+		"search_not_found":          "Nenhuma rodada encontrada.",
+		"export_gif":                "Exportar Gráfico (GIF)",
+		"err_export_no_match":       "Jogue uma partida antes de exportar o gráfico.",
+		"exact_analysis":            "Análise Exata (Markov)",
+		"err_exact_memory_one":      "A análise exata só é possível quando as duas estratégias são de memória um.",
+		"exact_result":              "Análise Exata (longo prazo, por rodada):\n%s: %.3f pontos\n%s: %.3f pontos\nTaxa de cooperação: %.1f%%\n",
+		"memory_one_name":           "Memória Um (%.2f/%.2f/%.2f/%.2f, inicial %.2f)",
+
+		"final_result": "Resultado Final:",
+		"points_line":  "%s: %d pontos",
+		"tie":          "Empate! Ambas terminaram com %d pontos.",
+		"winner":       "Vencedor: %s!",
+		"margin_lead":  "Margem: %d pontos (%.1f%% à frente)",
+		"margin":       "Margem: %d pontos",
+
+		"recap_cooperated": "cooperou",
+		"recap_defected":   "traiu",
+		"recap_sentence":   "Na rodada %d, %s %s e %s %s; placar %d a %d.",
+		"tooltip_outcome":  "A %s, B %s → A %+d, B %+d",
+		"tooltip_adjusted": "(com bônus/penalidades: A %+d, B %+d)",
+
+		"result_placeholder":      "Resultado aparecerá aqui...",
+		"start_tournament":        "Iniciar Torneio",
+		"copies_label":            "Cópias de cada estratégia:",
+		"err_invalid_copies":      "Por favor, insira um número de cópias válido para %s!",
+		"tiebreak_label":          "Critério de desempate:",
+		"tiebreak_cooperation":    "Taxa de cooperação",
+		"tiebreak_head_to_head":   "Confronto direto",
+		"tiebreak_alphabetical":   "Ordem alfabética",
+		"sort_label":              "Ordenar por:",
+		"sort_score":              "Pontuação",
+		"sort_name":               "Nome",
+		"sort_cooperation":        "Taxa de cooperação",
+		"filter_label":            "Mostrar:",
+		"filter_all":              "Todas as estratégias",
+		"filter_nice":             "Só as gentis (nunca traíram primeiro)",
+		"filter_stateless":        "Só as sem estado interno",
+		"filter_empty":            "Nenhuma estratégia corresponde ao filtro.",
+		"scale_label":             "Pontuação:",
+		"scale_total":             "Total",
+		"scale_per_match":         "Média por jogo",
+		"scale_per_round":         "Média por rodada",
+		"score_total":             "%d pontos",
+		"score_per_match":         "%.1f pontos por jogo",
+		"score_per_round":         "%.2f pontos por rodada",
+		"persistent_learners":     "Aprendizes persistentes (mantêm o aprendizado entre os jogos do torneio)",
+		"quality_check":           "Mostrar também a classificação ajustada pela força dos oponentes",
+		"shuffle_order":           "Embaralhar a ordem das estratégias (com semente própria para cada confronto, o resultado não deve mudar)",
+		"reps_label":              "Repetições do torneio (com mais de uma, mostra intervalos de confiança):",
+		"settings_header":         "Configuração usada:",
+		"settings_rounds":         "%d rodadas por jogo, %d repetição(ões)",
+		"settings_payoff":         "Matriz: R=%d, S=%d, T=%d, P=%d",
+		"settings_noise":          "Ruído: nenhum (as jogadas nunca são trocadas)",
+		"settings_noise_level":    "Ruído: %.1f%% das jogadas são trocadas pela oposta",
+		"settings_seed":           "Semente: %d",
+		"settings_no_seed":        "Semente: nenhuma (os sorteios não são reproduzíveis)",
+		"settings_shuffle":        "Ordem das estratégias embaralhada a partir da semente",
+		"settings_tiebreak":       "Desempate: %s",
+		"settings_history_window": "Janela de histórico: %d rodadas",
+		"settings_persistent":     "Aprendizes persistentes entre os jogos",
+		"settings_copies":         "Cópias: %s",
+		"err_invalid_reps":        "Por favor, insira um número de repetições válido!",
+		"confidence_label":        "Pontuação média por repetição, com intervalo de confiança de 95%:",
+		"compare_baseline":        "Usar como torneio A para comparação",
+		"share_caption":           "Suas anotações sobre o torneio (aparecem na imagem exportada)",
+		"share_png":               "Exportar resultados como PNG",
+		"share_title":             "Dilema do Prisioneiro — resultados do torneio",
+		"compare_label":           "Comparação entre o torneio A e o último torneio (B):",
+		"compare_header":          "Estratégia: posição e pontos em A → em B (variação)",
+		"compare_line":            "%s: %dº (%d) → %dº (%d) (%+d posições, %+d pontos)",
+		"compare_only_a":          "%s: só em A, %dº (%d)",
+		"compare_only_b":          "%s: só em B, %dº (%d)",
+		"narrative_winner":        "%s venceu o torneio com %d pontos, %d à frente de %s.",
+		"narrative_only":          "%s foi a única participante, com %d pontos.",
+		"narrative_nice_won":      "As estratégias gentis dominaram: %d das %d primeiras colocadas nunca traíram primeiro.",
+		"narrative_nasty_won":     "As estratégias que traem primeiro se saíram bem: só %d das %d primeiras colocadas são gentis.",
+		"narrative_noise_high":    "Nas %d repetições, a diferença entre as duas primeiras ficou dentro da margem de erro, então a sorte pode ter decidido.",
+		"narrative_noise_low":     "Nas %d repetições, a vitória ficou fora da margem de erro, então não foi sorte.",
+		"narrative_upset":         "Maior surpresa: %s (%dª) superou %s (%dª) no confronto direto por %.1f pontos por jogo.",
+		"class_cooperation":       "A cooperação emergiu",
+		"class_defection":         "A traição dominou",
+		"class_mixed":             "Resultado misto",
+		"ess_header":              "Estabilidade evolutiva das primeiras colocadas (uma população só dela resiste à invasão de cada outra estratégia?):",
+		"ess_stable":              "%s: estável (ESS)",
+		"ess_invadable":           "%s: invadível",
+		"quality_header":          "Classificação ajustada (pontos contra oponentes fortes valem mais; entre parênteses, posições ganhas ou perdidas):",
+		"quality_line":            "%d. %s: %.1f (%+d)",
+		"processing":              "Processando...",
+		"no_results":              "Nenhum resultado: selecione ao menos uma estratégia com uma ou mais cópias.",
+		"live_board_label":        "Classificação ao vivo:",
+		"live_board_header":       "Repetição %d — %d de %d confrontos disputados",
+		"category_label":          "Categoria:",
+		"category_all":            "Todas as categorias",
+		"category_select_all":     "Jogar só esta categoria",
+		"category_nice":           "Amáveis (nunca traem primeiro)",
+		"category_nasty":          "Agressivas (traem primeiro)",
+		"category_deterministic":  "Determinísticas",
+		"category_stochastic":     "Estocásticas (sorteiam jogadas)",
+		"category_reactive":       "Reativas (respondem ao oponente)",
+		"category_independent":    "Independentes (ignoram o oponente)",
+		"radar_label":             "Comparar estratégias (métricas normalizadas pelo maior valor entre as selecionadas):",
+		"metric_score":            "Pontuação",
+		"metric_cooperation":      "Cooperação",
+		"metric_niceness":         "Gentileza",
+		"metric_retaliation":      "Retaliação",
+		"metric_forgiveness":      "Perdão",
+		"coop_curve_label":        "Cooperação média por rodada (todos os confrontos):",
+		"dominance_graph":         "Quem explora quem (seta para quem fez mais de um ponto por rodada a menos no confronto direto):",
+		"results_header":          "Resultados Finais (ordenados por pontuação):",
+		"result_line":             "%d. %s: %s",
+		"most_cooperative":        "Confronto mais cooperativo: %s x %s (%.1f pontos combinados por jogo)",
+		"most_hostile":            "Confronto mais hostil: %s x %s (%.1f pontos combinados por jogo)",
+		"deviation_header":        "Desvio em relação a Tit-for-Tat (jogadas diferentes da previsão):",
+		"deviation_line":          "%s: %.1f%%",
+		"dominated_header":        "Estratégias dominadas:",
+		"forfeit_line":            "%s foi desclassificada em %d jogo(s) por um erro interno",
+		"nice_header":             "Estratégias gentis (nunca traíram primeiro):",
+		"nice_none":               "Nenhuma",
+		"dominated_line":          "%s é dominada por %s",
+		"heavy_title":             "Torneio pesado",
+		"heavy_message":           "O torneio terá cerca de %s rodadas no total e pode demorar bastante.\nDeseja continuar?",
+
+		"opponent_label":      "Estratégia adversária:",
+		"reveal_opponent":     "Mostrar a estratégia adversária durante o jogo",
+		"hidden_opponent":     "Oponente misterioso",
+		"opponent_revealed":   "O oponente misterioso era: %s",
+		"human_intro":         "Escolha a estratégia adversária e inicie o jogo.",
+		"cooperate":           "Cooperar",
+		"defect":              "Trair",
+		"err_choose_opponent": "Por favor, escolha a estratégia adversária!",
+		"human_turn":          "Rodada %d de %d: faça sua jogada.",
+		"human_history_line":  "Rodada %d: você %s, %s %s — placar %d a %d",
+		"human_history":       "Histórico:",
+		"you":                 "Você",
+		"no_description":      "Sem descrição disponível.",
+
+		// Descrições das estratégias
+		"desc_tit_for_tat":             "Coopera na primeira rodada e depois repete a última jogada do oponente.",
+		"desc_random":                  "Coopera ou trai ao acaso, com a mesma probabilidade.",
+		"desc_tideman":                 "Tit-for-Tat que perdoa uma traição se o oponente traiu pouco nas rodadas recentes.",
+		"desc_nydegger":                "Testa o oponente com cooperar, trair, cooperar; segue cooperando só se ele cooperou nas três, depois joga Tit-for-Tat.",
+		"desc_grofman":                 "Coopera quase sempre, mas trai a cada cinco rodadas, sem olhar o oponente.",
+		"desc_shubik":                  "Tit-for-Tat com punição prolongada: responde a cada traição com duas rodadas de traição.",
+		"desc_stein_rapoport":          "Tit-for-Tat que perdoa 20% das traições do oponente.",
+		"desc_friedman":                "Gatilho implacável: coopera até a primeira traição do oponente e depois trai para sempre.",
+		"desc_davis":                   "Coopera nas dez primeiras rodadas e depois joga Tit-for-Tat.",
+		"desc_graaskamp":               "Trai se o oponente traiu em mais da metade das rodadas até agora; senão, coopera.",
+		"desc_downing":                 "Conta as cooperações e traições do oponente e coopera enquanto ele coopera mais do que trai.",
+		"desc_feld":                    "Trai com probabilidade crescente ao longo do jogo, chegando a 100% na rodada 200.",
+		"desc_joss":                    "Tit-for-Tat que trai de surpresa em 10% das rodadas.",
+		"desc_tullock":                 "Coopera quase sempre, traindo ao acaso em 5% das rodadas para testar o oponente.",
+		"desc_name_withheld":           "Tit-for-Tat que trai de surpresa em 5% das rodadas.",
+		"desc_alternator":              "Alterna entre cooperar e trair, ignorando o oponente.",
+		"desc_always_cooperate":        "Coopera em todas as rodadas.",
+		"desc_always_defect":           "Trai em todas as rodadas.",
+		"desc_phased":                  "Joga uma estratégia até a rodada de troca e outra daí em diante.",
+		"desc_frequency_modeler":       "Aprende como o oponente responde a cada jogada sua e escolhe a que mais provoca cooperação.",
+		"desc_forgiving_pavlov":        "Repete a jogada que pontuou bem e troca a que pontuou mal, mas sai da traição mútua tentando cooperar.",
+		"desc_lagged_tit_for_tat":      "Tit-for-Tat com duas rodadas de atraso: repete a jogada do oponente de duas rodadas atrás.",
+		"desc_proportional_retaliator": "Após uma traição, retalia com probabilidade igual à taxa de traição do oponente até agora.",
+		"desc_reputation":              "Coopera com oponentes de boa reputação nos jogos anteriores do torneio e trai os de má reputação.",
+		"desc_reflective":              "Coopera com a mesma frequência com que o oponente cooperou até agora.",
+		"desc_fairness_enforcer":       "Tenta manter o placar empatado: trai quando está atrás e coopera quando está empatado ou à frente.",
+		"desc_catch_up":                "Coopera quando está empatado ou à frente; quando está atrás, trai com probabilidade que cresce com a desvantagem.",
+		"desc_q_learner":               "Aprende por reforço qual jogada rende mais após cada jogada do oponente, explorando ao acaso de vez em quando.",
+		"desc_stimulus_response":       "Estima como o oponente responde à sua cooperação e à sua traição e joga o que renderia mais.",
+		"desc_loop_exploiter":          "Sonda o oponente e, se ele responder por um padrão fixo (como Tit-for-Tat), joga a sequência que mais o explora.",
+		"desc_apologizer":              "Tit-for-Tat que pede desculpas: depois de trair quem cooperou, coopera e releva a retaliação.",
+		"desc_hardener":                "Joga Tit-for-Tat e começa generoso, perdoando traições com frequência, mas cada vez que coopera e é traído fica menos disposto a perdoar.",
+		"desc_maximin":                 "Escolhe sempre a jogada com a melhor pontuação no pior caso segundo a matriz; no dilema do prisioneiro clássico, trai sempre.",
+		"desc_prime_cooperator":        "Coopera nas rodadas de número primo (2, 3, 5, 7, ...) e trai nas demais, ignorando o oponente: um padrão irregular, mas determinístico.",
+		"desc_chameleon":               "Joga Tit-for-Tat nas 10 primeiras rodadas enquanto observa; se reconhecer a estratégia do oponente, passa a imitá-la, senão continua com Tit-for-Tat.",
+		"desc_annealer":                "Recozimento simulado: joga quase ao acaso no início e, à medida que a \"temperatura\" cai, passa a escolher a jogada que lhe rendeu a maior média de pontos.",
+		"desc_nice_retaliator":         "Nunca trai primeiro: só trai na rodada seguinte a uma traição do oponente, uma vez por traição, e volta a cooperar assim que ele coopera.",
+		"desc_neural":                  "Decide com uma pequena rede neural de pesos fixos, a partir das últimas 3 jogadas de cada jogador e da fração do jogo já disputada; os pesos padrão imitam Tit-for-Tat.",
+		"desc_time_healer":             "O tempo cura as feridas: depois de uma traição do oponente, a chance de cooperar cresce a cada rodada sem novas traições, até voltar a cooperar sempre após 5 rodadas.",
+		"desc_threshold_prober":        "Testa rajadas de traições cada vez mais longas até o oponente retaliar e então o explora com uma traição a menos; se ele não tolerar nenhuma, joga Tit-for-Tat.",
+		"desc_self_regulator":          "Ignora o oponente e coopera ou trai para manter a própria frequência de cooperação perto de um alvo (padrão: 60%).",
+		"desc_phase_matcher":           "Detecta o período do padrão do oponente e coopera nas fases em que ele coopera e trai nas fases em que ele trai; sem padrão, joga Tit-for-Tat.",
+		"desc_minimax_regret":          "Estima a chance de o oponente cooperar e escolhe a jogada de menor arrependimento no pior caso; no dilema clássico trai, mas em matrizes em que cooperar mutuamente paga mais que trair coopera com oponentes cooperativos.",
+		"desc_bayesian":                "Estima, pela regra de Bayes, se o oponente é Tit-for-Tat, Always Cooperate, Always Defect ou Random e joga a melhor resposta ao tipo mais provável: coopera com Tit-for-Tat e trai contra os demais. Trai uma vez na segunda rodada para testar o oponente.",
+		"desc_friedman_amnesty":        "Como Friedman, trai para sempre após a primeira traição do oponente, mas concede anistia e volta a cooperar se ele cooperar 5 rodadas seguidas mesmo sendo punido.",
+		"desc_escalating_punisher":     "Coopera, mas retalia cada traição do oponente com uma rajada mais longa que a anterior (uma traição na primeira, duas na segunda...), voltando a cooperar depois de cada rajada.",
+		"desc_deterministic_chaos":     "Ignora o oponente e coopera ou trai conforme um hash do número da rodada: parece aleatória, mas repete sempre a mesma sequência.",
+		"desc_ratio_targeter":          "Tenta manter a própria pontuação em um múltiplo fixo da do oponente (padrão: 1,5 vez), traindo quando está abaixo da proporção e cooperando quando está acima.",
+		"desc_occasional_tester":       "Joga Tit-for-Tat, mas, depois de uma longa sequência de cooperação mútua, às vezes trai uma única vez para testar se o oponente perdoa. Volta logo a cooperar e, se o oponente não perdoar, nunca mais testa.",
+		"desc_endgamer":                "Joga Tit-for-Tat, mas trai cada vez mais nas últimas rodadas quando sabe quantas rodadas o jogo tem.",
+		"desc_peace_offering":          "Joga Tit-for-Tat, mas oferece cooperação após algumas rodadas seguidas de traição mútua.",
+		"desc_memory_one":              "Coopera com uma probabilidade que depende só do resultado da rodada anterior.",
+		"desc_curve":                   "Trai com uma probabilidade que segue uma curva definida por pontos de controle ao longo das rodadas.",
+		"desc_mixed":                   "A cada rodada, joga uma de duas estratégias, escolhida ao acaso com a probabilidade definida.",
+		"desc_weighted_majority":       "Coopera se a maioria das jogadas do oponente foi cooperação, dando mais peso às mais recentes.",
+		"desc_negotiator":              "Oferece cooperação e se compromete se o oponente retribuir; a cada traição dá um aviso e oferece de novo, e só trai para sempre após duas ofertas ignoradas.",
+		"desc_meta_learner":            "Tit-for-Tat que perdoa com certa probabilidade e a ajusta entre um jogo e outro conforme sua pontuação melhora ou piora.",
+		"desc_human":                   "Suas jogadas, escolhidas pelos botões.",
+	},
+	"en": {
+		"app_title":                 "Spieltheorie - Game Theory",
+		"welcome":                   "Welcome to Spieltheorie!",
+		"mode_normal":               "Normal Mode",
+		"mode_tournament":           "Round-Robin Mode",
+		"mode_human":                "Play Against a Strategy",
+		"mode_gauntlet":             "Gauntlet",
+		"gauntlet_hero":             "Challenger strategy (faces every opponent, one after another):",
+		"gauntlet_opponents":        "Opponents, in the order they are ticked (reputation-aware strategies know how the challenger played against earlier ones):",
+		"gauntlet_start":            "Start Gauntlet",
+		"gauntlet_line":             "%d. %s %d × %d %s",
+		"gauntlet_forfeit":          "   (match interrupted: a strategy failed)",
+		"gauntlet_total":            "%s total: %d points (%.1f per opponent)",
+		"err_gauntlet_empty":        "Tick at least one opponent.",
+		"mode_history":              "Match History",
+		"back":                      "Back",
+		"history_empty":             "No normal-mode matches recorded yet.",
+		"history_line":              "%s — %s %d × %d %s (%d rounds, seed %d)",
+		"history_replay":            "Replay",
+		"history_differs":           "Warning: the result differs from the recorded one (the strategy may have changed since).",
+		"err_history_line":          "invalid history at line %d: %v",
+		"err_history_strategy":      "Strategy %q is not available to replay the match.",
+		"mode_tournament_log":       "Saved Tournaments",
+		"tournament_log_empty":      "No tournaments saved yet.",
+		"tournament_log_open":       "Open",
+		"tournament_log_matrix":     "Head-to-head matrix (points of the row against the column):",
+		"err_tournament_log":        "invalid saved tournament in %s: %v",
+		"err_tournament_log_matrix": "the matrix does not match the strategies",
+		"dark_theme":                "Dark theme",
+		"display_mode":              "Moves:",
+		"display_emoji":             "Emoji (✅/❌)",
+		"display_letters":           "Letters (C/D)",
+		"display_color":             "Color only",
+		"language":                  "Language:",
+		"load_plugin":               "Load strategy (Go plugin)",
+		"plugin_loaded":             "Strategy %q loaded and available in every mode.",
+		"self_test":                 "Reproducibility self-test",
+		"self_test_passed":          "Passed: the same tournament, played twice with the same seed, gave identical results.",
+		"err_self_test":             "Failed: the same tournament gave different results with the same seed (some strategy draws outside its own generator):\n%s\n%s",
+		"err_plugin_open":           "Could not open the plugin: %v",
+		"err_plugin_version":        "The plugin was built with a different version of Go or its dependencies; rebuild it with the same version as the program: %v",
+		"err_plugin_symbol":         "The plugin does not export the %s function.",
+		"err_plugin_signature":      "The plugin's %s function must have the signature func() any.",
+		"err_plugin_type":           "The plugin strategy must have the methods Name() string and NextMove(round int, opponentMoves []int) int.",
+		"err_plugin_duplicate":      "A strategy named %q already exists.",
+		"err_strategy_panic":        "Strategy %s failed in round %d and was disqualified: %v",
+
+		"rounds_label":          "Number of Rounds:",
+		"rounds_placeholder":    "Enter the number of rounds",
+		"reset_defaults":        "Restore Defaults",
+		"undo":                  "Undo",
+		"start_game":            "Start Game",
+		"err_invalid_rounds":    "Please enter a valid number of rounds!",
+		"coop_bonus_label":      "Bonus for consecutive mutual cooperation (points × streak length, 0 = off):",
+		"err_coop_bonus":        "Please enter a valid bonus (integer, zero or more)!",
+		"escalation_label":      "Consecutive mutual defection penalty (points off × rounds in a row after the first, 0 = disabled):",
+		"err_escalation":        "Please enter a valid penalty (integer greater than or equal to zero)!",
+		"payoff_label":          "Payoff matrix (R, S, T, P):",
+		"err_payoff":            "Invalid payoff matrix: %q (use four integers as R, S, T, P)",
+		"err_payoff_order":      "Invalid payoff matrix: %q (it must satisfy T > R > P > S)",
+		"history_window_label":  "History window (previous rounds the strategies can see, 0 = all):",
+		"start_score_label":     "Starting score of A and B (head start at the beginning of the game):",
+		"err_history_window":    "Please enter a valid window (an integer greater than or equal to zero)!",
+		"noise_label":           "Noise (probability that each move is flipped, from 0 to 1):",
+		"err_noise":             "Please enter a valid noise level (a number between 0 and 1)!",
+		"seed_label":            "Seed for the random draws (0 = a new one for each match):",
+		"tournament_seed_label": "Tournament seed (0 = none; with a shuffled order, a new one for each tournament):",
+		"err_seed":              "Please enter a valid seed (a whole number)!",
+		"target_score_label":    "Target score (the match ends when someone reaches it; the rounds become the cap, 0 = off):",
+		"err_target_score":      "Please enter a valid target score (an integer greater than or equal to zero)!",
+		"err_start_score":       "Please enter valid starting scores (whole numbers)!",
+		"race_winner":           "%s reached %d points first, in round %d.",
+		"race_tie":              "Both reached %d points in round %d with the same score: a tie.",
+		"race_cap":              "Nobody reached %d points in %d rounds.",
+		"swap_roles":            "Also play with swapped roles (B vs A) and show the average",
+		"swapped_average":       "Average of both orders: %s %.1f points, %s %.1f points",
+		"matchup_button":        "Strategy of the day (random match)",
+		"matchup_seed":          "Match drawn with seed %d",
+
+		"choose_a":                  "Choose Strategy A:",
+		"choose_b":                  "Choose Strategy B:",
+		"phased_option":             "Composite (strategy switch)",
+		"phased_label":              "Composite Strategy (plays the first until the switch round, then the second):",
+		"phased_name":               "%s → %s (round %d)",
+		"tideman_params":            "Tideman & Chieruzzi — round window and defection threshold for forgiving:",
+		"self_regulator_params":     "Self Regulator — target cooperation fraction (0 to 1):",
+		"ratio_targeter_params":     "Ratio Targeter — target ratio between its own score and the opponent's:",
+		"memory_one_option":         "Memory One (text)",
+		"memory_one_params":         "Memory One — P(C|CC)/P(C|CD)/P(C|DC)/P(C|DD)@P(initial C), e.g. mo:0.9/0.1/0.9/0.1@0.99",
+		"curve_option":              "Defection curve (points)",
+		"curve_params":              "Curve — round:defection probability points, comma-separated (e.g. 0:0, 100:0.2, 200:1):",
+		"curve_name":                "Curve (%s)",
+		"mixed_option":              "Probabilistic mix",
+		"mixed_params":              "Mix — each round plays the first with the chosen probability, otherwise the second:",
+		"mixed_probability":         "Probability of the first: %.0f%%",
+		"mixed_name":                "%.0f%% %s / %.0f%% %s",
+		"err_mixed_parts":           "Choose both strategies of the mix!",
+		"neural_option":             "Neural net (weights from a JSON file)",
+		"neural_params":             "Neural net — JSON file with \"oculta\" (rows of 7 weights + bias) and \"saida\" (one weight per hidden neuron + bias):",
+		"neural_load":               "Load weights…",
+		"neural_default":            "Default weights (imitate Tit-for-Tat)",
+		"err_neural_json":           "Invalid network weights: %v",
+		"err_neural_shape":          "Network weights: \"%s\" should have %d values but has %d.",
+		"err_curve_point":           "Invalid point: %q (expected round:probability)",
+		"err_curve_range":           "Probability outside [0, 1]: %q",
+		"err_curve_repeated":        "Round repeated in the curve: %d",
+		"copy":                      "Copy",
+		"err_mo_format":             "Invalid format: %q (expected mo:pCC/pCD/pDC/pDD@initial)",
+		"err_mo_range":              "Probability outside [0, 1]: %s",
+		"err_tideman_window":        "Please enter a valid window for Tideman & Chieruzzi!",
+		"err_tideman_threshold":     "Please enter a threshold between 0 and 1 for Tideman & Chieruzzi!",
+		"err_self_regulator_target": "Please enter a target between 0 and 1 for Self Regulator!",
+		"err_ratio_targeter":        "Please enter a target ratio greater than zero for Ratio Targeter!",
+		"err_choose_both":           "Please choose both strategies!",
+		"err_phased_switch":         "Please enter a valid switch round for the composite strategy!",
+		"err_phased_parts":          "Please choose both parts of the composite strategy!",
+		"progress":                  "Progress:",
+		"live_average":              "Show live average points per round",
+		"live_average_line":         "%s: %.2f points/round",
+		"history_label":             "Round History:",
+		"recap_label":               "Text Summary:",
+		"card_stats":                "Score: %d\nCooperation: %.0f%%\nExploited: %d times\nExploited the opponent: %d times",
+		"correlation_label":         "Move correlation (%d-round window; 1 = in sync, -1 = alternating):",
+		"col_round":                 "Round",
+		"col_move_a":                "Move A",
+		"col_move_b":                "Move B",
+		"col_score_a":               "Score A",
+		"col_score_b":               "Score B",
+		"col_points_a":              "Points A (round)",
+		"col_points_b":              "Points B (round)",
+		"search_first_defect_a":     "First defection by A",
+		"search_first_defect_b":     "First defection by B",
+		"search_first_dd":           "First DD",
+		"search_found":              "Round %d",
+		"search_not_found":          "No round found.",
+		"export_gif":                "Export Chart (GIF)",
+		"err_export_no_match":       "Play a match before exporting the chart.",
+		"exact_analysis":            "Exact Analysis (Markov)",
+		"err_exact_memory_one":      "Exact analysis is only possible when both strategies are memory-one.",
+		"exact_result":              "Exact Analysis (long run, per round):\n%s: %.3f points\n%s: %.3f points\nCooperation rate: %.1f%%\n",
+		"memory_one_name":           "Memory One (%.2f/%.2f/%.2f/%.2f, initial %.2f)",
+
+		"final_result": "Final Result:",
+		"points_line":  "%s: %d points",
+		"tie":          "Tie! Both finished with %d points.",
+		"winner":       "Winner: %s!",
+		"margin_lead":  "Margin: %d points (%.1f%% ahead)",
+		"margin":       "Margin: %d points",
+
+		"recap_cooperated": "cooperated",
+		"recap_defected":   "defected",
+		"recap_sentence":   "In round %d, %s %s and %s %s; score %d to %d.",
+		"tooltip_outcome":  "A %s, B %s → A %+d, B %+d",
+		"tooltip_adjusted": "(with bonuses/penalties: A %+d, B %+d)",
+
+		"result_placeholder":      "Results will appear here...",
+		"start_tournament":        "Start Tournament",
+		"copies_label":            "Copies of each strategy:",
+		"err_invalid_copies":      "Please enter a valid number of copies for %s!",
+		"tiebreak_label":          "Tie-break rule:",
+		"tiebreak_cooperation":    "Cooperation rate",
+		"tiebreak_head_to_head":   "Head-to-head",
+		"tiebreak_alphabetical":   "Alphabetical order",
+		"sort_label":              "Sort by:",
+		"sort_score":              "Score",
+		"sort_name":               "Name",
+		"sort_cooperation":        "Cooperation rate",
+		"filter_label":            "Show:",
+		"filter_all":              "All strategies",
+		"filter_nice":             "Only nice ones (never defected first)",
+		"filter_stateless":        "Only stateless ones",
+		"filter_empty":            "No strategy matches the filter.",
+		"scale_label":             "Score:",
+		"scale_total":             "Total",
+		"scale_per_match":         "Average per match",
+		"scale_per_round":         "Average per round",
+		"score_total":             "%d points",
+		"score_per_match":         "%.1f points per match",
+		"score_per_round":         "%.2f points per round",
+		"persistent_learners":     "Persistent learners (keep what they learned between tournament games)",
+		"quality_check":           "Also show the ranking adjusted for opponent strength",
+		"shuffle_order":           "Shuffle the strategy order (with a seed per pairing, the result should not change)",
+		"reps_label":              "Tournament repetitions (more than one shows confidence intervals):",
+		"settings_header":         "Settings used:",
+		"settings_rounds":         "%d rounds per game, %d repetition(s)",
+		"settings_payoff":         "Payoff matrix: R=%d, S=%d, T=%d, P=%d",
+		"settings_noise":          "Noise: none (moves are never flipped)",
+		"settings_noise_level":    "Noise: %.1f%% of moves are flipped",
+		"settings_seed":           "Seed: %d",
+		"settings_no_seed":        "Seed: none (random draws are not reproducible)",
+		"settings_shuffle":        "Strategy order shuffled from the seed",
+		"settings_tiebreak":       "Tie-break: %s",
+		"settings_history_window": "History window: %d rounds",
+		"settings_persistent":     "Persistent learners across games",
+		"settings_copies":         "Copies: %s",
+		"err_invalid_reps":        "Please enter a valid number of repetitions!",
+		"confidence_label":        "Mean score per repetition, with 95% confidence interval:",
+		"compare_baseline":        "Use as tournament A for comparison",
+		"share_caption":           "Your notes about the tournament (shown on the exported image)",
+		"share_png":               "Export results as PNG",
+		"share_title":             "Prisoner's Dilemma — tournament results",
+		"compare_label":           "Comparison between tournament A and the latest tournament (B):",
+		"compare_header":          "Strategy: rank and points in A → in B (change)",
+		"compare_line":            "%s: #%d (%d) → #%d (%d) (%+d places, %+d points)",
+		"compare_only_a":          "%s: only in A, #%d (%d)",
+		"compare_only_b":          "%s: only in B, #%d (%d)",
+		"narrative_winner":        "%s won the tournament with %d points, %d ahead of %s.",
+		"narrative_only":          "%s was the only participant, with %d points.",
+		"narrative_nice_won":      "Nice strategies dominated: %d of the top %d never defected first.",
+		"narrative_nasty_won":     "Strategies that defect first did well: only %d of the top %d are nice.",
+		"narrative_noise_high":    "Across %d repetitions, the gap between the top two stayed within the margin of error, so luck may have decided it.",
+		"narrative_noise_low":     "Across %d repetitions, the win stayed outside the margin of error, so it was not luck.",
+		"narrative_upset":         "Biggest upset: %s (#%d) beat %s (#%d) head-to-head by %.1f points per game.",
+		"class_cooperation":       "Cooperation emerged",
+		"class_defection":         "Defection dominated",
+		"class_mixed":             "Mixed outcome",
+		"ess_header":              "Evolutionary stability of the top strategies (does a population of only it resist invasion by every other strategy?):",
+		"ess_stable":              "%s: stable (ESS)",
+		"ess_invadable":           "%s: invadable",
+		"quality_header":          "Adjusted ranking (points against strong opponents count more; in parentheses, places gained or lost):",
+		"quality_line":            "%d. %s: %.1f (%+d)",
+		"processing":              "Processing...",
+		"no_results":              "No results: select at least one strategy with one or more copies.",
+		"live_board_label":        "Live standings:",
+		"live_board_header":       "Repetition %d — %d of %d pairings played",
+		"category_label":          "Category:",
+		"category_all":            "All categories",
+		"category_select_all":     "Play only this category",
+		"category_nice":           "Nice (never defect first)",
+		"category_nasty":          "Nasty (defect first)",
+		"category_deterministic":  "Deterministic",
+		"category_stochastic":     "Stochastic (draw moves at random)",
+		"category_reactive":       "Reactive (respond to the opponent)",
+		"category_independent":    "Independent (ignore the opponent)",
+		"radar_label":             "Compare strategies (metrics normalized by the highest value among the selected):",
+		"metric_score":            "Score",
+		"metric_cooperation":      "Cooperation",
+		"metric_niceness":         "Niceness",
+		"metric_retaliation":      "Retaliation",
+		"metric_forgiveness":      "Forgiveness",
+		"coop_curve_label":        "Average cooperation per round (all pairings):",
+		"dominance_graph":         "Who exploits whom (arrow towards whoever scored over one point per round less head-to-head):",
+		"results_header":          "Final Results (sorted by score):",
+		"result_line":             "%d. %s: %s",
+		"most_cooperative":        "Most cooperative pairing: %s x %s (%.1f combined points per game)",
+		"most_hostile":            "Most hostile pairing: %s x %s (%.1f combined points per game)",
+		"deviation_header":        "Deviation from Tit-for-Tat (moves differing from its prediction):",
+		"deviation_line":          "%s: %.1f%%",
+		"dominated_header":        "Dominated strategies:",
+		"forfeit_line":            "%s was disqualified in %d game(s) due to an internal error",
+		"nice_header":             "Nice strategies (never defected first):",
+		"nice_none":               "None",
+		"dominated_line":          "%s is dominated by %s",
+		"heavy_title":             "Heavy tournament",
+		"heavy_message":           "The tournament will have about %s rounds in total and may take a long time.\nDo you want to continue?",
+
+		"opponent_label":      "Opponent strategy:",
+		"reveal_opponent":     "Show the opponent strategy during the match",
+		"hidden_opponent":     "Mystery opponent",
+		"opponent_revealed":   "The mystery opponent was: %s",
+		"human_intro":         "Choose the opponent strategy and start the game.",
+		"cooperate":           "Cooperate",
+		"defect":              "Defect",
+		"err_choose_opponent": "Please choose the opponent strategy!",
+		"human_turn":          "Round %d of %d: make your move.",
+		"human_history_line":  "Round %d: you %s, %s %s — score %d to %d",
+		"human_history":       "History:",
+		"you":                 "You",
+		"no_description":      "No description available.",
+
+		// Descrições das estratégias
+		"desc_tit_for_tat":             "Cooperates in the first round, then repeats the opponent's last move.",
+		"desc_random":                  "Cooperates or defects at random with equal probability.",
+		"desc_tideman":                 "Tit-for-Tat that forgives a defection if the opponent rarely defected in recent rounds.",
+		"desc_nydegger":                "Probes with cooperate, defect, cooperate; keeps cooperating only if the opponent cooperated in all three, then plays Tit-for-Tat.",
+		"desc_grofman":                 "Cooperates almost always but defects every fifth round, ignoring the opponent.",
+		"desc_shubik":                  "Tit-for-Tat with longer punishment: answers each defection with two rounds of defection.",
+		"desc_stein_rapoport":          "Tit-for-Tat that forgives 20% of the opponent's defections.",
+		"desc_friedman":                "Grim trigger: cooperates until the opponent's first defection, then defects forever.",
+		"desc_davis":                   "Cooperates for the first ten rounds, then plays Tit-for-Tat.",
+		"desc_graaskamp":               "Defects if the opponent defected in more than half of the rounds so far; otherwise cooperates.",
+		"desc_downing":                 "Counts the opponent's cooperations and defections and cooperates while it cooperates more than it defects.",
+		"desc_feld":                    "Defects with a probability that grows during the game, reaching 100% at round 200.",
+		"desc_joss":                    "Tit-for-Tat that sneakily defects in 10% of the rounds.",
+		"desc_tullock":                 "Cooperates almost always, defecting at random in 5% of the rounds to test the opponent.",
+		"desc_name_withheld":           "Tit-for-Tat that sneakily defects in 5% of the rounds.",
+		"desc_alternator":              "Alternates between cooperating and defecting, ignoring the opponent.",
+		"desc_always_cooperate":        "Cooperates in every round.",
+		"desc_always_defect":           "Defects in every round.",
+		"desc_phased":                  "Plays one strategy until the switch round and another from then on.",
+		"desc_frequency_modeler":       "Learns how the opponent responds to each of its moves and picks the one that draws the most cooperation.",
+		"desc_forgiving_pavlov":        "Repeats a move that scored well and switches one that scored badly, but leaves mutual defection by trying to cooperate.",
+		"desc_lagged_tit_for_tat":      "Tit-for-Tat with a two-round delay: repeats the opponent's move from two rounds ago.",
+		"desc_proportional_retaliator": "After a defection, retaliates with probability equal to the opponent's defection rate so far.",
+		"desc_reputation":              "Cooperates with opponents that earned a good reputation in earlier tournament games and defects against the rest.",
+		"desc_reflective":              "Cooperates as often as the opponent has cooperated so far.",
+		"desc_fairness_enforcer":       "Tries to keep the score level: defects when behind and cooperates when level or ahead.",
+		"desc_catch_up":                "Cooperates when level or ahead; when behind, defects with a probability that grows with the deficit.",
+		"desc_q_learner":               "Learns by reinforcement which move pays best after each opponent move, exploring at random now and then.",
+		"desc_stimulus_response":       "Estimates how the opponent responds to its cooperation and to its defection, and plays whatever would pay more.",
+		"desc_loop_exploiter":          "Probes the opponent and, if it responds by a fixed pattern (such as Tit-for-Tat), plays the sequence that exploits it most.",
+		"desc_apologizer":              "Tit-for-Tat that apologizes: after defecting against a cooperator, it cooperates and lets the retaliation pass.",
+		"desc_hardener":                "Plays Tit-for-Tat and starts generous, often forgiving defections, but each time it cooperates and gets betrayed it becomes less willing to forgive.",
+		"desc_maximin":                 "Always picks the move with the best worst-case payoff under the matrix; in the classic prisoner's dilemma it always defects.",
+		"desc_prime_cooperator":        "Cooperates on prime-numbered rounds (2, 3, 5, 7, ...) and defects otherwise, ignoring the opponent: an irregular but deterministic pattern.",
+		"desc_chameleon":               "Plays Tit-for-Tat for the first 10 rounds while watching; if it recognizes the opponent strategy it starts imitating it, otherwise it keeps playing Tit-for-Tat.",
+		"desc_annealer":                "Simulated annealing: plays almost at random at first and, as the \"temperature\" cools, increasingly picks the move with the highest average payoff so far.",
+		"desc_nice_retaliator":         "Never defects first: it only defects in the round after an opponent defection, once per defection, and cooperates again as soon as the opponent does.",
+		"desc_neural":                  "Decides with a small fixed-weight neural network fed the last 3 moves of each player and the fraction of the match played; the default weights imitate Tit-for-Tat.",
+		"desc_time_healer":             "Time heals wounds: after an opponent defection, its chance of cooperating grows each round without new defections, until it always cooperates again after 5 rounds.",
+		"desc_threshold_prober":        "Tests ever longer bursts of defections until the opponent retaliates, then exploits it with one defection fewer; if it tolerates none, plays Tit-for-Tat.",
+		"desc_self_regulator":          "Ignores the opponent and cooperates or defects to keep its own cooperation frequency near a target (default: 60%).",
+		"desc_phase_matcher":           "Detects the period of the opponent's pattern and cooperates in the phases where it cooperates and defects where it defects; without a pattern, plays Tit-for-Tat.",
+		"desc_minimax_regret":          "Estimates the opponent's chance of cooperating and picks the move with the smallest worst-case regret; in the classic dilemma it defects, but under matrices where mutual cooperation pays more than defecting it cooperates with cooperative opponents.",
+		"desc_bayesian":                "Uses Bayes' rule to estimate whether the opponent is Tit-for-Tat, Always Cooperate, Always Defect or Random and plays the best response to the most likely type: cooperates with Tit-for-Tat and defects against the others. Defects once in the second round to test the opponent.",
+		"desc_friedman_amnesty":        "Like Friedman, defects forever after the opponent's first defection, but grants amnesty and returns to cooperation if the opponent cooperates 5 rounds in a row despite being punished.",
+		"desc_escalating_punisher":     "Cooperates, but retaliates against each opponent defection with a longer burst than the last (one defection for the first, two for the second...), returning to cooperation after each burst.",
+		"desc_deterministic_chaos":     "Ignores the opponent and cooperates or defects according to a hash of the round number: it looks random, but always repeats the same sequence.",
+		"desc_ratio_targeter":          "Tries to keep its own score at a fixed multiple of the opponent's (default: 1.5 times), defecting when below the ratio and cooperating when above it.",
+		"desc_occasional_tester":       "Plays Tit-for-Tat, but after a long run of mutual cooperation it occasionally defects once to test whether the opponent forgives. It returns to cooperation right away and stops testing for good if the opponent does not forgive.",
+		"desc_endgamer":                "Plays Tit-for-Tat but defects more and more in the last rounds when it knows how long the game is.",
+		"desc_peace_offering":          "Plays Tit-for-Tat but offers cooperation after a few consecutive rounds of mutual defection.",
+		"desc_memory_one":              "Cooperates with a probability that depends only on the previous round's outcome.",
+		"desc_curve":                   "Defects with a probability that follows a curve defined by control points over the rounds.",
+		"desc_mixed":                   "Each round, plays one of two strategies, picked at random with the chosen probability.",
+		"desc_weighted_majority":       "Cooperates if most of the opponent's moves were cooperative, giving more weight to recent ones.",
+		"desc_negotiator":              "Offers cooperation and commits if the opponent reciprocates; answers each defection with one warning and a new offer, and defects forever only after two ignored offers.",
+		"desc_meta_learner":            "Tit-for-Tat that forgives with some probability and tunes it between games as its score improves or worsens.",
+		"desc_human":                   "Your moves, chosen with the buttons.",
+	},
+	"de": {
+		"app_title":                 "Spieltheorie",
+		"welcome":                   "Willkommen bei Spieltheorie!",
+		"mode_normal":               "Normaler Modus",
+		"mode_tournament":           "Jeder gegen Jeden",
+		"mode_human":                "Gegen eine Strategie spielen",
+		"mode_gauntlet":             "Spießrutenlauf",
+		"gauntlet_hero":             "Herausfordernde Strategie (tritt nacheinander gegen alle Gegner an):",
+		"gauntlet_opponents":        "Gegner in der Reihenfolge des Ankreuzens (reputationsbewusste Strategien wissen, wie der Herausforderer gegen frühere gespielt hat):",
+		"gauntlet_start":            "Herausforderung starten",
+		"gauntlet_line":             "%d. %s %d × %d %s",
+		"gauntlet_forfeit":          "   (Spiel abgebrochen: eine Strategie ist fehlgeschlagen)",
+		"gauntlet_total":            "Gesamt für %s: %d Punkte (%.1f pro Gegner)",
+		"err_gauntlet_empty":        "Kreuzen Sie mindestens einen Gegner an.",
+		"mode_history":              "Spielverlauf",
+		"back":                      "Zurück",
+		"history_empty":             "Noch keine Partien im Normalmodus aufgezeichnet.",
+		"history_line":              "%s — %s %d × %d %s (%d Runden, Seed %d)",
+		"history_replay":            "Erneut spielen",
+		"history_differs":           "Achtung: Das Ergebnis weicht vom aufgezeichneten ab (die Strategie hat sich eventuell geändert).",
+		"err_history_line":          "ungültiger Verlauf in Zeile %d: %v",
+		"err_history_strategy":      "Die Strategie %q ist zum erneuten Spielen nicht verfügbar.",
+		"mode_tournament_log":       "Gespeicherte Turniere",
+		"tournament_log_empty":      "Noch keine Turniere gespeichert.",
+		"tournament_log_open":       "Öffnen",
+		"tournament_log_matrix":     "Begegnungsmatrix (Punkte der Zeile gegen die Spalte):",
+		"err_tournament_log":        "ungültiges gespeichertes Turnier in %s: %v",
+		"err_tournament_log_matrix": "die Matrix passt nicht zu den Strategien",
+		"dark_theme":                "Dunkles Design",
+		"display_mode":              "Züge:",
+		"display_emoji":             "Emoji (✅/❌)",
+		"display_letters":           "Buchstaben (C/D)",
+		"display_color":             "Nur Farbe",
+		"language":                  "Sprache:",
+		"load_plugin":               "Strategie laden (Go-Plugin)",
+		"plugin_loaded":             "Strategie %q geladen und in allen Modi verfügbar.",
+		"self_test":                 "Reproduzierbarkeits-Selbsttest",
+		"self_test_passed":          "Bestanden: Dasselbe Turnier, zweimal mit demselben Seed gespielt, ergab identische Ergebnisse.",
+		"err_self_test":             "Fehlgeschlagen: Dasselbe Turnier ergab mit demselben Seed unterschiedliche Ergebnisse (eine Strategie zieht außerhalb ihres eigenen Generators):\n%s\n%s",
+		"err_plugin_open":           "Das Plugin konnte nicht geöffnet werden: %v",
+		"err_plugin_version":        "Das Plugin wurde mit einer anderen Go- oder Abhängigkeitsversion gebaut; bitte mit derselben Version wie das Programm neu bauen: %v",
+		"err_plugin_symbol":         "Das Plugin exportiert die Funktion %s nicht.",
+		"err_plugin_signature":      "Die Funktion %s des Plugins muss die Signatur func() any haben.",
+		"err_plugin_type":           "Die Plugin-Strategie muss die Methoden Name() string und NextMove(round int, opponentMoves []int) int haben.",
+		"err_plugin_duplicate":      "Es gibt bereits eine Strategie namens %q.",
+		"err_strategy_panic":        "Die Strategie %s ist in Runde %d abgestürzt und wurde disqualifiziert: %v",
+
+		"rounds_label":          "Anzahl der Runden:",
+		"rounds_placeholder":    "Anzahl der Runden eingeben",
+		"reset_defaults":        "Standardwerte",
+		"undo":                  "Rückgängig",
+		"start_game":            "Spiel starten",
+		"err_invalid_rounds":    "Bitte eine gültige Rundenanzahl eingeben!",
+		"coop_bonus_label":      "Bonus für aufeinanderfolgende gegenseitige Kooperation (Punkte × Serienlänge, 0 = aus):",
+		"err_coop_bonus":        "Bitte einen gültigen Bonus eingeben (ganze Zahl ab null)!",
+		"escalation_label":      "Strafe für aufeinanderfolgenden beidseitigen Verrat (Punkte weniger × Runden nach der ersten, 0 = deaktiviert):",
+		"err_escalation":        "Bitte geben Sie eine gültige Strafe ein (ganze Zahl größer oder gleich null)!",
+		"payoff_label":          "Auszahlungsmatrix (R, S, T, P):",
+		"err_payoff":            "Ungültige Auszahlungsmatrix: %q (vier ganze Zahlen im Format R, S, T, P)",
+		"err_payoff_order":      "Ungültige Auszahlungsmatrix: %q (es muss T > R > P > S gelten)",
+		"history_window_label":  "Verlaufsfenster (frühere Runden, die die Strategien sehen, 0 = alle):",
+		"start_score_label":     "Startpunktzahl von A und B (Vorsprung zu Spielbeginn):",
+		"err_history_window":    "Bitte geben Sie ein gültiges Fenster ein (ganze Zahl größer oder gleich null)!",
+		"noise_label":           "Rauschen (Wahrscheinlichkeit, dass ein Zug vertauscht wird, von 0 bis 1):",
+		"err_noise":             "Bitte geben Sie ein gültiges Rauschen ein (Zahl zwischen 0 und 1)!",
+		"seed_label":            "Seed für die Zufallsziehungen (0 = ein neuer für jedes Spiel):",
+		"tournament_seed_label": "Turnier-Seed (0 = keiner; bei gemischter Reihenfolge ein neuer für jedes Turnier):",
+		"err_seed":              "Bitte geben Sie einen gültigen Seed ein (ganze Zahl)!",
+		"target_score_label":    "Zielpunktzahl (das Spiel endet, sobald jemand sie erreicht; die Runden werden zur Obergrenze, 0 = aus):",
+		"err_target_score":      "Bitte geben Sie eine gültige Zielpunktzahl ein (ganze Zahl größer oder gleich null)!",
+		"err_start_score":       "Bitte gültige Startpunktzahlen eingeben (ganze Zahlen)!",
+		"race_winner":           "%s erreichte %d Punkte zuerst, in Runde %d.",
+		"race_tie":              "Beide erreichten %d Punkte in Runde %d mit gleichem Stand: Unentschieden.",
+		"race_cap":              "Niemand erreichte %d Punkte in %d Runden.",
+		"swap_roles":            "Auch mit getauschten Rollen (B gegen A) spielen und den Durchschnitt zeigen",
+		"swapped_average":       "Durchschnitt beider Reihenfolgen: %s %.1f Punkte, %s %.1f Punkte",
+		"matchup_button":        "Strategie des Tages (zufälliges Spiel)",
+		"matchup_seed":          "Spiel mit Seed %d ausgelost",
+
+		"choose_a":                  "Strategie A wählen:",
+		"choose_b":                  "Strategie B wählen:",
+		"phased_option":             "Zusammengesetzt (Strategiewechsel)",
+		"phased_label":              "Zusammengesetzte Strategie (spielt die erste bis zur Wechselrunde, danach die zweite):",
+		"phased_name":               "%s → %s (Runde %d)",
+		"tideman_params":            "Tideman & Chieruzzi — Rundenfenster und Verratsschwelle für Vergebung:",
+		"self_regulator_params":     "Self Regulator — angestrebter Kooperationsanteil (0 bis 1):",
+		"ratio_targeter_params":     "Ratio Targeter — Zielverhältnis zwischen eigener und gegnerischer Punktzahl:",
+		"memory_one_option":         "Memory One (Text)",
+		"memory_one_params":         "Memory One — P(C|CC)/P(C|CD)/P(C|DC)/P(C|DD)@P(C am Anfang), z. B. mo:0.9/0.1/0.9/0.1@0.99",
+		"curve_option":              "Verratskurve (Punkte)",
+		"curve_params":              "Kurve — Punkte Runde:Verratswahrscheinlichkeit, durch Kommas getrennt (z. B. 0:0, 100:0.2, 200:1):",
+		"curve_name":                "Kurve (%s)",
+		"mixed_option":              "Zufallsmischung",
+		"mixed_params":              "Mischung — spielt in jeder Runde mit der gewählten Wahrscheinlichkeit die erste, sonst die zweite:",
+		"mixed_probability":         "Wahrscheinlichkeit der ersten: %.0f %%",
+		"mixed_name":                "%.0f %% %s / %.0f %% %s",
+		"err_mixed_parts":           "Wählen Sie beide Strategien der Mischung!",
+		"neural_option":             "Neuronales Netz (Gewichte aus JSON-Datei)",
+		"neural_params":             "Neuronales Netz — JSON-Datei mit \"oculta\" (Zeilen mit 7 Gewichten + Bias) und \"saida\" (ein Gewicht pro versteckt. Neuron + Bias):",
+		"neural_load":               "Gewichte laden…",
+		"neural_default":            "Standardgewichte (ahmen Tit-for-Tat nach)",
+		"err_neural_json":           "Ungültige Netzgewichte: %v",
+		"err_neural_shape":          "Netzgewichte: \"%s\" sollte %d Werte haben, hat aber %d.",
+		"err_curve_point":           "Ungültiger Punkt: %q (erwartet Runde:Wahrscheinlichkeit)",
+		"err_curve_range":           "Wahrscheinlichkeit außerhalb von [0, 1]: %q",
+		"err_curve_repeated":        "Runde in der Kurve wiederholt: %d",
+		"copy":                      "Kopieren",
+		"err_mo_format":             "Ungültiges Format: %q (erwartet mo:pCC/pCD/pDC/pDD@Anfang)",
+		"err_mo_range":              "Wahrscheinlichkeit außerhalb von [0, 1]: %s",
+		"err_tideman_window":        "Bitte ein gültiges Fenster für Tideman & Chieruzzi eingeben!",
+		"err_tideman_threshold":     "Bitte eine Schwelle zwischen 0 und 1 für Tideman & Chieruzzi eingeben!",
+		"err_self_regulator_target": "Bitte ein Ziel zwischen 0 und 1 für Self Regulator eingeben!",
+		"err_ratio_targeter":        "Bitte ein Zielverhältnis größer als null für Ratio Targeter eingeben!",
+		"err_choose_both":           "Bitte beide Strategien wählen!",
+		"err_phased_switch":         "Bitte eine gültige Wechselrunde für die zusammengesetzte Strategie eingeben!",
+		"err_phased_parts":          "Bitte beide Teile der zusammengesetzten Strategie wählen!",
+		"progress":                  "Fortschritt:",
+		"live_average":              "Durchschnittliche Punkte pro Runde live anzeigen",
+		"live_average_line":         "%s: %.2f Punkte/Runde",
+		"history_label":             "Rundenverlauf:",
+		"recap_label":               "Textzusammenfassung:",
+		"card_stats":                "Punkte: %d\nKooperation: %.0f %%\nAusgenutzt worden: %d-mal\nGegner ausgenutzt: %d-mal",
+		"correlation_label":         "Korrelation der Züge (Fenster von %d Runden; 1 = synchron, -1 = abwechselnd):",
+		"col_round":                 "Runde",
+		"col_move_a":                "Zug A",
+		"col_move_b":                "Zug B",
+		"col_score_a":               "Punkte A",
+		"col_score_b":               "Punkte B",
+		"col_points_a":              "Punkte A (Runde)",
+		"col_points_b":              "Punkte B (Runde)",
+		"search_first_defect_a":     "Erster Verrat von A",
+		"search_first_defect_b":     "Erster Verrat von B",
+		"search_first_dd":           "Erstes DD",
+		"search_found":              "Runde %d",
+		"search_not_found":          "Keine Runde gefunden.",
+		"export_gif":                "Diagramm exportieren (GIF)",
+		"err_export_no_match":       "Spiele eine Partie, bevor du das Diagramm exportierst.",
+		"exact_analysis":            "Exakte Analyse (Markov)",
+		"err_exact_memory_one":      "Die exakte Analyse ist nur möglich, wenn beide Strategien Memory-One sind.",
+		"exact_result":              "Exakte Analyse (langfristig, pro Runde):\n%s: %.3f Punkte\n%s: %.3f Punkte\nKooperationsrate: %.1f%%\n",
+		"memory_one_name":           "Memory One (%.2f/%.2f/%.2f/%.2f, anfangs %.2f)",
+
+		"final_result": "Endergebnis:",
+		"points_line":  "%s: %d Punkte",
+		"tie":          "Unentschieden! Beide endeten mit %d Punkten.",
+		"winner":       "Gewinner: %s!",
+		"margin_lead":  "Vorsprung: %d Punkte (%.1f%% voraus)",
+		"margin":       "Vorsprung: %d Punkte",
+
+		"recap_cooperated": "kooperierte",
+		"recap_defected":   "verriet",
+		"recap_sentence":   "In Runde %[1]d %[3]s %[2]s und %[4]s %[5]s; Stand %[6]d zu %[7]d.",
+		"tooltip_outcome":  "A %s, B %s → A %+d, B %+d",
+		"tooltip_adjusted": "(mit Boni/Strafen: A %+d, B %+d)",
+
+		"result_placeholder":      "Das Ergebnis erscheint hier...",
+		"start_tournament":        "Turnier starten",
+		"copies_label":            "Kopien jeder Strategie:",
+		"err_invalid_copies":      "Bitte eine gültige Anzahl Kopien für %s eingeben!",
+		"tiebreak_label":          "Entscheidungskriterium bei Gleichstand:",
+		"tiebreak_cooperation":    "Kooperationsrate",
+		"tiebreak_head_to_head":   "Direkter Vergleich",
+		"tiebreak_alphabetical":   "Alphabetische Reihenfolge",
+		"sort_label":              "Sortieren nach:",
+		"sort_score":              "Punkte",
+		"sort_name":               "Name",
+		"sort_cooperation":        "Kooperationsrate",
+		"filter_label":            "Anzeigen:",
+		"filter_all":              "Alle Strategien",
+		"filter_nice":             "Nur freundliche (nie zuerst verraten)",
+		"filter_stateless":        "Nur zustandslose",
+		"filter_empty":            "Keine Strategie entspricht dem Filter.",
+		"scale_label":             "Punkte:",
+		"scale_total":             "Gesamt",
+		"scale_per_match":         "Durchschnitt pro Spiel",
+		"scale_per_round":         "Durchschnitt pro Runde",
+		"score_total":             "%d Punkte",
+		"score_per_match":         "%.1f Punkte pro Spiel",
+		"score_per_round":         "%.2f Punkte pro Runde",
+		"persistent_learners":     "Persistente Lerner (behalten das Gelernte zwischen den Turnierspielen)",
+		"quality_check":           "Auch die nach Gegnerstärke gewichtete Rangliste anzeigen",
+		"shuffle_order":           "Reihenfolge der Strategien mischen (mit eigenem Seed pro Paarung sollte sich das Ergebnis nicht ändern)",
+		"reps_label":              "Turnierwiederholungen (bei mehr als einer werden Konfidenzintervalle gezeigt):",
+		"settings_header":         "Verwendete Einstellungen:",
+		"settings_rounds":         "%d Runden pro Spiel, %d Wiederholung(en)",
+		"settings_payoff":         "Auszahlungsmatrix: R=%d, S=%d, T=%d, P=%d",
+		"settings_noise":          "Rauschen: keines (Züge werden nie vertauscht)",
+		"settings_noise_level":    "Rauschen: %.1f%% der Züge werden vertauscht",
+		"settings_seed":           "Seed: %d",
+		"settings_no_seed":        "Seed: keiner (Zufallsziehungen sind nicht reproduzierbar)",
+		"settings_shuffle":        "Reihenfolge der Strategien aus dem Seed gemischt",
+		"settings_tiebreak":       "Gleichstand: %s",
+		"settings_history_window": "Verlaufsfenster: %d Runden",
+		"settings_persistent":     "Dauerhafte Lernende über die Spiele hinweg",
+		"settings_copies":         "Kopien: %s",
+		"err_invalid_reps":        "Bitte eine gültige Anzahl an Wiederholungen eingeben!",
+		"confidence_label":        "Durchschnittliche Punktzahl pro Wiederholung mit 95-%-Konfidenzintervall:",
+		"compare_baseline":        "Als Turnier A zum Vergleich verwenden",
+		"share_caption":           "Deine Notizen zum Turnier (erscheinen auf dem exportierten Bild)",
+		"share_png":               "Ergebnisse als PNG exportieren",
+		"share_title":             "Gefangenendilemma — Turnierergebnisse",
+		"compare_label":           "Vergleich zwischen Turnier A und dem letzten Turnier (B):",
+		"compare_header":          "Strategie: Platz und Punkte in A → in B (Änderung)",
+		"compare_line":            "%s: Platz %d (%d) → Platz %d (%d) (%+d Plätze, %+d Punkte)",
+		"compare_only_a":          "%s: nur in A, Platz %d (%d)",
+		"compare_only_b":          "%s: nur in B, Platz %d (%d)",
+		"narrative_winner":        "%s gewann das Turnier mit %d Punkten, %d vor %s.",
+		"narrative_only":          "%s war die einzige Teilnehmerin, mit %d Punkten.",
+		"narrative_nice_won":      "Freundliche Strategien dominierten: %d der besten %d haben nie zuerst verraten.",
+		"narrative_nasty_won":     "Strategien, die zuerst verraten, schnitten gut ab: nur %d der besten %d sind freundlich.",
+		"narrative_noise_high":    "Über %d Wiederholungen blieb der Abstand der beiden Ersten innerhalb der Fehlermarge; das Glück könnte entschieden haben.",
+		"narrative_noise_low":     "Über %d Wiederholungen lag der Sieg außerhalb der Fehlermarge; es war also kein Glück.",
+		"narrative_upset":         "Größte Überraschung: %s (Platz %d) schlug %s (Platz %d) im direkten Vergleich um %.1f Punkte pro Spiel.",
+		"class_cooperation":       "Kooperation hat sich durchgesetzt",
+		"class_defection":         "Verrat hat dominiert",
+		"class_mixed":             "Gemischtes Ergebnis",
+		"ess_header":              "Evolutionäre Stabilität der Bestplatzierten (widersteht eine Population nur aus ihr dem Eindringen jeder anderen Strategie?):",
+		"ess_stable":              "%s: stabil (ESS)",
+		"ess_invadable":           "%s: invadierbar",
+		"quality_header":          "Gewichtete Rangliste (Punkte gegen starke Gegner zählen mehr; in Klammern gewonnene oder verlorene Plätze):",
+		"quality_line":            "%d. %s: %.1f (%+d)",
+		"processing":              "Wird berechnet...",
+		"no_results":              "Keine Ergebnisse: Wählen Sie mindestens eine Strategie mit einer oder mehr Kopien.",
+		"live_board_label":        "Live-Tabelle:",
+		"live_board_header":       "Wiederholung %d — %d von %d Begegnungen gespielt",
+		"category_label":          "Kategorie:",
+		"category_all":            "Alle Kategorien",
+		"category_select_all":     "Nur diese Kategorie spielen",
+		"category_nice":           "Freundlich (verraten nie zuerst)",
+		"category_nasty":          "Aggressiv (verraten zuerst)",
+		"category_deterministic":  "Deterministisch",
+		"category_stochastic":     "Stochastisch (losen Züge aus)",
+		"category_reactive":       "Reaktiv (reagieren auf den Gegner)",
+		"category_independent":    "Unabhängig (ignorieren den Gegner)",
+		"radar_label":             "Strategien vergleichen (Kennzahlen normiert auf den höchsten Wert der Auswahl):",
+		"metric_score":            "Punkte",
+		"metric_cooperation":      "Kooperation",
+		"metric_niceness":         "Freundlichkeit",
+		"metric_retaliation":      "Vergeltung",
+		"metric_forgiveness":      "Vergebung",
+		"coop_curve_label":        "Durchschnittliche Kooperation pro Runde (alle Paarungen):",
+		"dominance_graph":         "Wer wen ausnutzt (Pfeil zu dem, der im direkten Duell über einen Punkt pro Runde weniger erzielte):",
+		"results_header":          "Endergebnisse (nach Punkten sortiert):",
+		"result_line":             "%d. %s: %s",
+		"most_cooperative":        "Kooperativste Paarung: %s x %s (%.1f gemeinsame Punkte pro Spiel)",
+		"most_hostile":            "Feindseligste Paarung: %s x %s (%.1f gemeinsame Punkte pro Spiel)",
+		"deviation_header":        "Abweichung von Tit-for-Tat (Züge abweichend von dessen Vorhersage):",
+		"deviation_line":          "%s: %.1f %%",
+		"dominated_header":        "Dominierte Strategien:",
+		"forfeit_line":            "%s wurde in %d Spiel(en) wegen eines internen Fehlers disqualifiziert",
+		"nice_header":             "Freundliche Strategien (haben nie zuerst verraten):",
+		"nice_none":               "Keine",
+		"dominated_line":          "%s wird von %s dominiert",
+		"heavy_title":             "Aufwendiges Turnier",
+		"heavy_message":           "Das Turnier umfasst insgesamt etwa %s Runden und kann lange dauern.\nMöchtest du fortfahren?",
+
+		"opponent_label":      "Gegnerische Strategie:",
+		"reveal_opponent":     "Gegnerische Strategie während des Spiels anzeigen",
+		"hidden_opponent":     "Geheimer Gegner",
+		"opponent_revealed":   "Der geheime Gegner war: %s",
+		"human_intro":         "Wähle die gegnerische Strategie und starte das Spiel.",
+		"cooperate":           "Kooperieren",
+		"defect":              "Verraten",
+		"err_choose_opponent": "Bitte die gegnerische Strategie wählen!",
+		"human_turn":          "Runde %d von %d: mach deinen Zug.",
+		"human_history_line":  "Runde %d: du %s, %s %s — Stand %d zu %d",
+		"human_history":       "Verlauf:",
+		"you":                 "Du",
+		"no_description":      "Keine Beschreibung verfügbar.",
+
+		// Descrições das estratégias
+		"desc_tit_for_tat":             "Kooperiert in der ersten Runde und wiederholt dann den letzten Zug des Gegners.",
+		"desc_random":                  "Kooperiert oder verrät zufällig, jeweils mit gleicher Wahrscheinlichkeit.",
+		"desc_tideman":                 "Tit-for-Tat, das einen Verrat vergibt, wenn der Gegner in den letzten Runden selten verraten hat.",
+		"desc_nydegger":                "Testet mit Kooperieren, Verraten, Kooperieren; kooperiert nur weiter, wenn der Gegner alle drei Male kooperiert hat, danach Tit-for-Tat.",
+		"desc_grofman":                 "Kooperiert fast immer, verrät aber jede fünfte Runde, ohne auf den Gegner zu achten.",
+		"desc_shubik":                  "Tit-for-Tat mit längerer Strafe: beantwortet jeden Verrat mit zwei Runden Verrat.",
+		"desc_stein_rapoport":          "Tit-for-Tat, das 20 % der Verrate des Gegners vergibt.",
+		"desc_friedman":                "Grim Trigger: kooperiert bis zum ersten Verrat des Gegners und verrät danach für immer.",
+		"desc_davis":                   "Kooperiert in den ersten zehn Runden und spielt dann Tit-for-Tat.",
+		"desc_graaskamp":               "Verrät, wenn der Gegner bisher in mehr als der Hälfte der Runden verraten hat; sonst kooperiert er.",
+		"desc_downing":                 "Zählt Kooperationen und Verrate des Gegners und kooperiert, solange er öfter kooperiert als verrät.",
+		"desc_feld":                    "Verrät mit im Spielverlauf steigender Wahrscheinlichkeit, die in Runde 200 100 % erreicht.",
+		"desc_joss":                    "Tit-for-Tat, das in 10 % der Runden überraschend verrät.",
+		"desc_tullock":                 "Kooperiert fast immer und verrät zufällig in 5 % der Runden, um den Gegner zu testen.",
+		"desc_name_withheld":           "Tit-for-Tat, das in 5 % der Runden überraschend verrät.",
+		"desc_alternator":              "Wechselt zwischen Kooperieren und Verraten, ohne auf den Gegner zu achten.",
+		"desc_always_cooperate":        "Kooperiert in jeder Runde.",
+		"desc_always_defect":           "Verrät in jeder Runde.",
+		"desc_phased":                  "Spielt eine Strategie bis zur Wechselrunde und danach eine andere.",
+		"desc_frequency_modeler":       "Lernt, wie der Gegner auf jeden eigenen Zug reagiert, und wählt den, der am meisten Kooperation hervorruft.",
+		"desc_forgiving_pavlov":        "Wiederholt einen erfolgreichen Zug und wechselt einen erfolglosen, verlässt gegenseitigen Verrat aber mit einem Kooperationsversuch.",
+		"desc_lagged_tit_for_tat":      "Tit-for-Tat mit zwei Runden Verzögerung: wiederholt den Zug des Gegners von vor zwei Runden.",
+		"desc_proportional_retaliator": "Vergilt einen Verrat mit einer Wahrscheinlichkeit gleich der bisherigen Verratsquote des Gegners.",
+		"desc_reputation":              "Kooperiert mit Gegnern, die in früheren Turnierspielen einen guten Ruf erworben haben, und verrät die übrigen.",
+		"desc_reflective":              "Kooperiert so oft, wie der Gegner bisher kooperiert hat.",
+		"desc_fairness_enforcer":       "Versucht, den Punktestand ausgeglichen zu halten: verrät bei Rückstand und kooperiert bei Gleichstand oder Führung.",
+		"desc_catch_up":                "Kooperiert bei Gleichstand oder Führung; bei Rückstand verrät es mit einer Wahrscheinlichkeit, die mit dem Rückstand wächst.",
+		"desc_q_learner":               "Lernt durch Verstärkung, welcher Zug nach jedem Zug des Gegners am meisten bringt, und probiert ab und zu zufällig etwas aus.",
+		"desc_stimulus_response":       "Schätzt, wie der Gegner auf seine Kooperation und seinen Verrat reagiert, und spielt, was mehr einbringt.",
+		"desc_loop_exploiter":          "Testet den Gegner und spielt, wenn er nach einem festen Muster antwortet (etwa Tit-for-Tat), die Folge, die ihn am meisten ausnutzt.",
+		"desc_apologizer":              "Tit-for-Tat, das sich entschuldigt: Nach einem Verrat an einem Kooperierenden kooperiert es und lässt die Vergeltung durchgehen.",
+		"desc_hardener":                "Spielt Tit-for-Tat und beginnt großzügig, vergibt Verrat oft, wird aber jedes Mal, wenn es kooperiert und verraten wird, weniger nachsichtig.",
+		"desc_maximin":                 "Wählt immer den Zug mit der besten Auszahlung im schlimmsten Fall laut Matrix; im klassischen Gefangenendilemma verrät es immer.",
+		"desc_prime_cooperator":        "Kooperiert in Runden mit Primzahl (2, 3, 5, 7, ...) und verrät sonst, ohne den Gegner zu beachten: ein unregelmäßiges, aber deterministisches Muster.",
+		"desc_chameleon":               "Spielt in den ersten 10 Runden Tit-for-Tat und beobachtet; erkennt es die gegnerische Strategie, ahmt es sie nach, sonst bleibt es bei Tit-for-Tat.",
+		"desc_annealer":                "Simulierte Abkühlung: spielt anfangs fast zufällig und wählt mit sinkender \"Temperatur\" zunehmend den Zug mit der höchsten bisherigen Durchschnittsauszahlung.",
+		"desc_nice_retaliator":         "Verrät nie zuerst: verrät nur in der Runde nach einem Verrat des Gegners, einmal pro Verrat, und kooperiert wieder, sobald der Gegner kooperiert.",
+		"desc_neural":                  "Entscheidet mit einem kleinen neuronalen Netz mit festen Gewichten aus den letzten 3 Zügen beider Spieler und dem gespielten Anteil; die Standardgewichte ahmen Tit-for-Tat nach.",
+		"desc_time_healer":             "Die Zeit heilt Wunden: Nach einem Verrat des Gegners steigt die Kooperationschance mit jeder Runde ohne neuen Verrat, bis es nach 5 Runden wieder immer kooperiert.",
+		"desc_threshold_prober":        "Testet immer längere Verratsserien, bis der Gegner zurückschlägt, und nutzt ihn dann mit einem Verrat weniger aus; toleriert er keinen, spielt es Tit-for-Tat.",
+		"desc_self_regulator":          "Ignoriert den Gegner und kooperiert oder verrät, um die eigene Kooperationsrate nahe an einem Ziel zu halten (Standard: 60 %).",
+		"desc_phase_matcher":           "Erkennt die Periode des gegnerischen Musters, kooperiert in den Phasen, in denen er kooperiert, und verrät, wo er verrät; ohne Muster spielt es Tit-for-Tat.",
+		"desc_minimax_regret":          "Schätzt die Kooperationswahrscheinlichkeit des Gegners und wählt den Zug mit dem geringsten Bedauern im schlimmsten Fall; im klassischen Dilemma verrät es, aber bei Matrizen, in denen gegenseitige Kooperation mehr als Verrat bringt, kooperiert es mit kooperativen Gegnern.",
+		"desc_bayesian":                "Schätzt mit der Bayes-Regel, ob der Gegner Tit-for-Tat, Always Cooperate, Always Defect oder Random ist, und spielt die beste Antwort auf den wahrscheinlichsten Typ: kooperiert mit Tit-for-Tat und verrät die anderen. Verrät in der zweiten Runde einmal, um den Gegner zu testen.",
+		"desc_friedman_amnesty":        "Wie Friedman verrät es nach dem ersten Verrat des Gegners für immer, gewährt aber Amnestie und kooperiert wieder, wenn der Gegner trotz Bestrafung 5 Runden in Folge kooperiert.",
+		"desc_escalating_punisher":     "Kooperiert, vergilt aber jeden Verrat des Gegners mit einer längeren Serie als zuvor (ein Verrat beim ersten, zwei beim zweiten ...) und kooperiert nach jeder Serie wieder.",
+		"desc_deterministic_chaos":     "Ignoriert den Gegner und kooperiert oder verrät je nach einem Hash der Rundennummer: wirkt zufällig, wiederholt aber immer dieselbe Folge.",
+		"desc_ratio_targeter":          "Versucht, die eigene Punktzahl bei einem festen Vielfachen der gegnerischen zu halten (Standard: 1,5-fach), verrät unterhalb des Verhältnisses und kooperiert oberhalb.",
+		"desc_occasional_tester":       "Spielt Tit-for-Tat, verrät aber nach einer langen Phase gegenseitiger Kooperation gelegentlich einmal, um zu prüfen, ob der Gegner verzeiht. Kehrt sofort zur Kooperation zurück und testet nie wieder, wenn der Gegner nicht verzeiht.",
+		"desc_endgamer":                "Spielt Tit-for-Tat, verrät aber in den letzten Runden immer häufiger, wenn es die Spiellänge kennt.",
+		"desc_peace_offering":          "Spielt Tit-for-Tat, bietet aber nach einigen Runden gegenseitigen Verrats Kooperation an.",
+		"desc_memory_one":              "Kooperiert mit einer Wahrscheinlichkeit, die nur vom Ergebnis der vorigen Runde abhängt.",
+		"desc_curve":                   "Verrät mit einer Wahrscheinlichkeit, die einer durch Kontrollpunkte definierten Kurve über die Runden folgt.",
+		"desc_mixed":                   "Spielt in jeder Runde eine von zwei Strategien, zufällig mit der gewählten Wahrscheinlichkeit ausgewählt.",
+		"desc_weighted_majority":       "Kooperiert, wenn die meisten Züge des Gegners kooperativ waren, wobei jüngere stärker zählen.",
+		"desc_negotiator":              "Bietet Kooperation an und bleibt dabei, wenn der Gegner sie erwidert; beantwortet jeden Verrat mit einer Warnung und einem neuen Angebot und verrät erst nach zwei ignorierten Angeboten für immer.",
+		"desc_meta_learner":            "Tit-for-Tat, das mit einer gewissen Wahrscheinlichkeit vergibt und diese zwischen den Spielen je nach Punkteentwicklung anpasst.",
+		"desc_human":                   "Ihre Züge, gewählt über die Schaltflächen.",
+	},
+}
+
+// tr retorna o texto da chave no idioma atual, recorrendo ao idioma padrão e, por fim, à própria chave
+func tr(key string) string {
+	if text, ok := messages[language][key]; ok {
+		return text
+	}
+	if text, ok := messages[defaultLanguage][key]; ok {
+		return text
+	}
+	return key
+}
+
+// setLanguage troca o idioma da interface e salva a escolha nas preferências
+func setLanguage(a fyne.App, lang string) {
+	if _, ok := messages[lang]; !ok {
+		lang = defaultLanguage
+	}
+	language = lang
+	a.Preferences().SetString(languagePreferenceKey, lang)
+}
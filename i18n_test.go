@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestEveryKeyExistsInEveryLanguage(t *testing.T) {
+	// Todas as chaves de todos os idiomas
+	keys := make(map[string]bool)
+	for _, catalog := range messages {
+		for key := range catalog {
+			keys[key] = true
+		}
+	}
+	if len(messages) != len(languages) {
+		t.Errorf("%d catálogos para %d idiomas", len(messages), len(languages))
+	}
+	for _, lang := range languages {
+		catalog, ok := messages[lang]
+		if !ok {
+			t.Errorf("idioma %s sem catálogo", lang)
+			continue
+		}
+		var missing []string
+		for key := range keys {
+			if strings.TrimSpace(catalog[key]) == "" {
+				missing = append(missing, key)
+			}
+		}
+		sort.Strings(missing)
+		if len(missing) > 0 {
+			t.Errorf("idioma %s sem as chaves %v", lang, missing)
+		}
+	}
+
+	// Toda chave usada com tr no código existe no catálogo
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	used := regexp.MustCompile(`\btr\("([a-z0-9_]+)"\)`)
+	for _, file := range files {
+		source, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, match := range used.FindAllStringSubmatch(string(source), -1) {
+			if !keys[match[1]] {
+				t.Errorf("%s usa a chave %q, que não está no catálogo", file, match[1])
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Aparência do gráfico de linha
+const (
+	lineChartMinWidth  = 400
+	lineChartMinHeight = 200
+	lineChartMargin    = 36  // Espaço para os rótulos dos eixos
+	lineChartMaxPoints = 200 // Curvas longas são subamostradas para limitar o número de segmentos
+)
+
+// LineChart é um gráfico de linha simples de uma série, por padrão com valores entre 0 e 1 (uma
+// taxa), com uma posição no eixo x para cada valor. Valores NaN (indefinidos) ficam sem linha
+type LineChart struct {
+	widget.BaseWidget
+	values              []float64
+	low, high           float64 // Valores na base e no topo do eixo y
+	lowLabel, highLabel string
+}
+
+// NewLineChart cria um gráfico de linha vazio, com o eixo y de 0% a 100%
+func NewLineChart() *LineChart {
+	chart := &LineChart{low: 0, high: 1, lowLabel: "0%", highLabel: "100%"}
+	chart.ExtendBaseWidget(chart)
+	return chart
+}
+
+// SetRange muda o intervalo e os rótulos do eixo y
+func (c *LineChart) SetRange(low, high float64, lowLabel, highLabel string) {
+	c.low, c.high, c.lowLabel, c.highLabel = low, high, lowLabel, highLabel
+	c.Refresh()
+}
+
+// SetValues substitui a série exibida e redesenha o gráfico
+func (c *LineChart) SetValues(values []float64) {
+	c.values = values
+	c.Refresh()
+}
+
+func (c *LineChart) CreateRenderer() fyne.WidgetRenderer {
+	return &lineChartRenderer{chart: c}
+}
+
+// lineChartRenderer recria as linhas e os textos do gráfico a cada mudança de tamanho ou de dados
+type lineChartRenderer struct {
+	chart   *LineChart
+	objects []fyne.CanvasObject
+}
+
+func (r *lineChartRenderer) Layout(size fyne.Size) {
+	r.objects = r.objects[:0]
+	width, height := size.Width-2*lineChartMargin, size.Height-2*lineChartMargin
+	if width <= 0 || height <= 0 {
+		return
+	}
+	foreground := theme.Color(theme.ColorNameForeground)
+	line := func(from, to fyne.Position, width float32) {
+		l := canvas.NewLine(foreground)
+		l.StrokeWidth = width
+		l.Position1, l.Position2 = from, to
+		r.objects = append(r.objects, l)
+	}
+	text := func(s string, pos fyne.Position) {
+		t := canvas.NewText(s, foreground)
+		t.TextSize = theme.CaptionTextSize()
+		t.Move(pos)
+		r.objects = append(r.objects, t)
+	}
+
+	// Eixos, com o intervalo no eixo y e a primeira e a última rodada no eixo x
+	chart := r.chart
+	origin := fyne.NewPos(lineChartMargin, lineChartMargin+height)
+	line(origin, fyne.NewPos(lineChartMargin+width, origin.Y), 1)
+	line(origin, fyne.NewPos(lineChartMargin, lineChartMargin), 1)
+	text(chart.highLabel, fyne.NewPos(0, lineChartMargin-8))
+	text(chart.lowLabel, fyne.NewPos(8, origin.Y-8))
+	scale := func(value float64) float32 {
+		return origin.Y - height*float32((value-chart.low)/(chart.high-chart.low))
+	}
+	// Linha de referência no zero, se ele estiver dentro do intervalo
+	if chart.low < 0 && chart.high > 0 {
+		zero := canvas.NewLine(theme.Color(theme.ColorNameDisabled))
+		zero.Position1, zero.Position2 = fyne.NewPos(lineChartMargin, scale(0)), fyne.NewPos(lineChartMargin+width, scale(0))
+		r.objects = append(r.objects, zero)
+	}
+
+	values := chart.values
+	n := len(values)
+	if n == 0 {
+		return
+	}
+	text("1", fyne.NewPos(lineChartMargin, origin.Y+4))
+	text(fmt.Sprint(n), fyne.NewPos(lineChartMargin+width-16, origin.Y+4))
+
+	// Converte (índice, valor) em coordenadas; com um único valor, desenha uma linha horizontal
+	point := func(i int) fyne.Position {
+		x := float32(0)
+		if n > 1 {
+			x = width * float32(i) / float32(n-1)
+		}
+		return fyne.NewPos(lineChartMargin+x, scale(values[i]))
+	}
+	step := max(1, n/lineChartMaxPoints)
+	prev, prevDefined := point(0), !math.IsNaN(values[0])
+	if n == 1 {
+		if prevDefined {
+			line(prev, fyne.NewPos(lineChartMargin+width, prev.Y), 2)
+		}
+		return
+	}
+	for i := step; ; i += step {
+		next, nextDefined := point(min(i, n-1)), !math.IsNaN(values[min(i, n-1)])
+		if prevDefined && nextDefined {
+			line(prev, next, 2)
+		}
+		if i >= n-1 {
+			break
+		}
+		prev, prevDefined = next, nextDefined
+	}
+}
+
+func (r *lineChartRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(lineChartMinWidth, lineChartMinHeight)
+}
+
+func (r *lineChartRenderer) Refresh() {
+	r.Layout(r.chart.Size())
+	canvas.Refresh(r.chart)
+}
+
+func (r *lineChartRenderer) Objects() []fyne.CanvasObject { return r.objects }
+func (r *lineChartRenderer) Destroy()                     {}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// liveSteps é em quantas etapas o torneio é dividido para publicar a classificação parcial, e
+// liveRefresh o intervalo com que a interface a redesenha
+const (
+	liveSteps   = 100
+	liveRefresh = 250 * time.Millisecond
+)
+
+// LiveStandings guarda os totais parciais de um torneio em andamento, para a classificação ao
+// vivo. É escrito pela goroutine do torneio e lido pela interface, então todo acesso passa pelo mutex
+type LiveStandings struct {
+	mu               sync.Mutex
+	repetition       int
+	completed, total int // Confrontos disputados e total de confrontos da repetição atual
+	scores           map[string]int
+}
+
+// startRepetition zera os totais parciais no início de uma repetição do torneio (a partir de 1)
+func (l *LiveStandings) startRepetition(repetition int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.repetition, l.completed, l.total, l.scores = repetition, 0, 0, nil
+}
+
+// publish copia os totais acumulados no checkpoint depois de completed dos total confrontos
+func (l *LiveStandings) publish(c *TournamentCheckpoint, total int) {
+	scores := make(map[string]int, len(c.TotalScores))
+	for name, score := range c.TotalScores {
+		scores[name] = score
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.completed, l.total, l.scores = c.Completed, total, scores
+}
+
+// snapshot retorna a classificação parcial, por pontuação (empates pelo nome), e o progresso
+func (l *LiveStandings) snapshot() (standings []Result, repetition, completed, total int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for name, score := range l.scores {
+		standings = append(standings, Result{name: name, score: score})
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		if standings[i].score != standings[j].score {
+			return standings[i].score > standings[j].score
+		}
+		return standings[i].name < standings[j].name
+	})
+	for i := range standings {
+		standings[i].rank = i + 1
+	}
+	return standings, l.repetition, l.completed, l.total
+}
+
+// advanceLive joga todos os confrontos restantes do checkpoint em etapas, publicando os totais
+// parciais em live ao fim de cada uma
+func advanceLive(c *TournamentCheckpoint, strategies []Strategy, live *LiveStandings) {
+	total := len(tournamentPairings(strategies, c.Config))
+	step := max(1, total/liveSteps)
+	for !c.advance(strategies, step) {
+		live.publish(c, total)
+	}
+	live.publish(c, total)
+}
+
+// formatLiveStandings monta o texto da classificação ao vivo
+func formatLiveStandings(live *LiveStandings) string {
+	standings, repetition, completed, total := live.snapshot()
+	var board strings.Builder
+	board.WriteString(fmt.Sprintf(tr("live_board_header")+"\n", repetition, completed, total))
+	for _, result := range standings {
+		board.WriteString(fmt.Sprintf(tr("result_line")+"\n", result.rank, result.name, formatThousands(int64(result.score))))
+	}
+	return board.String()
+}
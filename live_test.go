@@ -0,0 +1,49 @@
+package main
+
+import "testing"
+
+func TestLiveTotalsMatchRecomputation(t *testing.T) {
+	forbidGlobalRNG(t)
+	strategies := []Strategy{TitForTat{}, &Joss{}, AlwaysDefect{}, &Random{}}
+	cfg := TournamentConfig{Rounds: 20, Seed: 3}
+	pairings := tournamentPairings(strategies, cfg)
+	checkpoint := newTournamentCheckpoint(strategies, cfg)
+	live := &LiveStandings{}
+	want := make(map[string]int)
+	for k, pairing := range pairings {
+		checkpoint.advance(strategies, 1)
+		live.publish(checkpoint, len(pairings))
+
+		// Recalcula o confronto k sozinho, com as sementes que o torneio dá a ele (sem pesos, cada
+		// confronto é jogado uma vez só)
+		a, b := strategies[pairing[0]], strategies[pairing[1]]
+		seedA, seedB := pairingSeed(cfg.Seed, a.Name(), b.Name(), 0), pairingSeed(cfg.Seed, b.Name(), a.Name(), 0)
+		if seedA == seedB {
+			seedB++
+		}
+		game := NewGame(freshInstance(a), freshInstance(b), cfg.Rounds)
+		game.seedSides(seedA, seedB)
+		for round := 0; round < cfg.Rounds; round++ {
+			if err := game.PlayRound(round); err != nil {
+				t.Fatal(err)
+			}
+		}
+		want[a.Name()] += game.scores[0]
+		want[b.Name()] += game.scores[1]
+
+		standings, _, completed, total := live.snapshot()
+		if completed != k+1 || total != len(pairings) {
+			t.Fatalf("progresso %d/%d, esperado %d/%d", completed, total, k+1, len(pairings))
+		}
+		for _, result := range standings {
+			if result.score != want[result.name] {
+				t.Errorf("após %d confrontos, %s tem %d pontos parciais, recalculado %d", k+1, result.name, result.score, want[result.name])
+			}
+		}
+		for i := 1; i < len(standings); i++ {
+			if standings[i].score > standings[i-1].score {
+				t.Errorf("após %d confrontos, a classificação parcial não está ordenada: %v", k+1, standings)
+			}
+		}
+	}
+}
@@ -0,0 +1,325 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Estados de uma rodada do ponto de vista de um jogador: (própria jogada, jogada do oponente)
+const (
+	stateCC = iota
+	stateCD
+	stateDC
+	stateDD
+)
+
+// outcomeState retorna o estado da rodada do ponto de vista de quem jogou own
+func outcomeState(own, opponent Choice) int {
+	return int(own)*2 + int(opponent)
+}
+
+// MemoryOne: Coopera com uma probabilidade que depende só do resultado da rodada anterior
+// (CC, CD, DC ou DD, do seu ponto de vista) e com probabilidade initial na primeira rodada
+type MemoryOne struct {
+	randomized
+	probs    [4]float64 // P(cooperar | CC), P(cooperar | CD), P(cooperar | DC), P(cooperar | DD)
+	initial  float64
+	lastMove Choice
+}
+
+// NewMemoryOne cria uma estratégia de memória um com as probabilidades de cooperação dadas
+func NewMemoryOne(pCC, pCD, pDC, pDD, initial float64) *MemoryOne {
+	return &MemoryOne{probs: [4]float64{pCC, pCD, pDC, pDD}, initial: initial}
+}
+
+func (s *MemoryOne) NextMove(round int, opponentMoves []Choice) Choice {
+	p := s.initial
+	if round > 0 && len(opponentMoves) > 0 {
+		p = s.probs[outcomeState(s.lastMove, opponentMoves[len(opponentMoves)-1])]
+	}
+	s.lastMove = Defect
+	if s.random().Float64() < p {
+		s.lastMove = Cooperate
+	}
+	return s.lastMove
+}
+func (s *MemoryOne) Name() string {
+	return fmt.Sprintf(tr("memory_one_name"), s.probs[0], s.probs[1], s.probs[2], s.probs[3], s.initial)
+}
+func (s *MemoryOne) Reset()          { s.lastMove = Cooperate }
+func (s *MemoryOne) Clone() Strategy { return &MemoryOne{probs: s.probs, initial: s.initial} }
+func (s *MemoryOne) AsMemoryOne() MemoryOne {
+	return MemoryOne{probs: s.probs, initial: s.initial}
+}
+
+// MemoryOneStrategy é implementada por estratégias que equivalem a uma estratégia de memória um
+type MemoryOneStrategy interface {
+	AsMemoryOne() MemoryOne
+}
+
+// Equivalentes de memória um das estratégias registradas que só dependem da rodada anterior
+func (s TitForTat) AsMemoryOne() MemoryOne {
+	return MemoryOne{probs: [4]float64{1, 0, 1, 0}, initial: 1}
+}
+func (s Random) AsMemoryOne() MemoryOne {
+	return MemoryOne{probs: [4]float64{0.5, 0.5, 0.5, 0.5}, initial: 0.5}
+}
+func (s SteinRapoport) AsMemoryOne() MemoryOne {
+	return MemoryOne{probs: [4]float64{1, 0.2, 1, 0.2}, initial: 1}
+}
+func (s Joss) AsMemoryOne() MemoryOne {
+	return MemoryOne{probs: [4]float64{0.9, 0, 0.9, 0}, initial: 1}
+}
+func (s Tullock) AsMemoryOne() MemoryOne {
+	return MemoryOne{probs: [4]float64{0.95, 0.95, 0.95, 0.95}, initial: 0.95}
+}
+func (s NameWithheld) AsMemoryOne() MemoryOne {
+	return MemoryOne{probs: [4]float64{0.95, 0, 0.95, 0}, initial: 1}
+}
+func (s AlwaysCooperate) AsMemoryOne() MemoryOne {
+	return MemoryOne{probs: [4]float64{1, 1, 1, 1}, initial: 1}
+}
+func (s AlwaysDefect) AsMemoryOne() MemoryOne {
+	return MemoryOne{probs: [4]float64{0, 0, 0, 0}, initial: 0}
+}
+func (s ForgivingPavlov) AsMemoryOne() MemoryOne {
+	return MemoryOne{probs: [4]float64{1, 0, 0, 1}, initial: 1}
+}
+
+// markovEpsilon é a tolerância para considerar zero um pivô na solução dos sistemas lineares
+const markovEpsilon = 1e-12
+
+// solveLinear resolve o sistema a·x = b por eliminação de Gauss com pivoteamento parcial. a e b são
+// alterados; retorna false se o sistema for singular
+func solveLinear(a [][]float64, b []float64) ([]float64, bool) {
+	n := len(b)
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(a[pivot][col]) < markovEpsilon {
+			return nil, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+		for row := col + 1; row < n; row++ {
+			factor := a[row][col] / a[col][col]
+			for k := col; k < n; k++ {
+				a[row][k] -= factor * a[col][k]
+			}
+			b[row] -= factor * b[col]
+		}
+	}
+	x := make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		sum := b[row]
+		for k := row + 1; k < n; k++ {
+			sum -= a[row][k] * x[k]
+		}
+		x[row] = sum / a[row][row]
+	}
+	return x, true
+}
+
+// closedClasses retorna as classes fechadas (recorrentes) da cadeia: conjuntos de estados que se
+// alcançam entre si e dos quais não se sai. Os estados fora delas são transitórios
+func closedClasses(transition [4][4]float64) [][]int {
+	var reach [4][4]bool
+	for i := 0; i < 4; i++ {
+		reach[i][i] = true
+		for j := 0; j < 4; j++ {
+			if transition[i][j] > 0 {
+				reach[i][j] = true
+			}
+		}
+	}
+	for k := 0; k < 4; k++ {
+		for i := 0; i < 4; i++ {
+			for j := 0; j < 4; j++ {
+				reach[i][j] = reach[i][j] || reach[i][k] && reach[k][j]
+			}
+		}
+	}
+
+	var classes [][]int
+	assigned := [4]bool{}
+	for i := 0; i < 4; i++ {
+		if assigned[i] {
+			continue
+		}
+		var class []int
+		closed := true
+		for j := 0; j < 4; j++ {
+			if reach[i][j] && reach[j][i] {
+				class = append(class, j)
+				assigned[j] = true
+			} else if reach[i][j] {
+				closed = false
+			}
+		}
+		if closed {
+			classes = append(classes, class)
+		}
+	}
+	return classes
+}
+
+// classStationary resolve a distribuição estacionária da cadeia restrita à classe fechada: as
+// equações de equilíbrio π = π·P, com uma delas trocada pela soma das probabilidades igual a 1
+func classStationary(transition [4][4]float64, class []int) [4]float64 {
+	n := len(class)
+	a := make([][]float64, n)
+	b := make([]float64, n)
+	for row := range a {
+		a[row] = make([]float64, n)
+		for col, from := range class {
+			a[row][col] = transition[from][class[row]]
+			if col == row {
+				a[row][col]--
+			}
+		}
+	}
+	for col := range a[n-1] {
+		a[n-1][col] = 1
+	}
+	b[n-1] = 1
+
+	var dist [4]float64
+	if x, ok := solveLinear(a, b); ok {
+		for i, state := range class {
+			dist[state] = x[i]
+		}
+	}
+	return dist
+}
+
+// absorption retorna, para cada estado, a probabilidade de a cadeia partindo dele acabar na
+// classe fechada: 1 nos estados da classe, 0 nas outras classes fechadas e, nos transitórios, a
+// solução de (I - P_TT)·h = P_T,classe·1
+func absorption(transition [4][4]float64, classes [][]int, target int) [4]float64 {
+	var h [4]float64
+	recurrent := [4]bool{}
+	for c, class := range classes {
+		for _, state := range class {
+			recurrent[state] = true
+			if c == target {
+				h[state] = 1
+			}
+		}
+	}
+	var transient []int
+	for state := 0; state < 4; state++ {
+		if !recurrent[state] {
+			transient = append(transient, state)
+		}
+	}
+	if len(transient) == 0 {
+		return h
+	}
+
+	a := make([][]float64, len(transient))
+	b := make([]float64, len(transient))
+	for row, from := range transient {
+		a[row] = make([]float64, len(transient))
+		for col, to := range transient {
+			a[row][col] = -transition[from][to]
+			if col == row {
+				a[row][col]++
+			}
+		}
+		for _, to := range classes[target] {
+			b[row] += transition[from][to]
+		}
+	}
+	if x, ok := solveLinear(a, b); ok {
+		for i, state := range transient {
+			h[state] = x[i]
+		}
+	}
+	return h
+}
+
+// stationaryOutcome calcula de forma exata (sem simulação) a pontuação média por rodada de cada
+// estratégia e a taxa de cooperação de longo prazo, a partir da cadeia de Markov de 4 estados
+// formada pelas duas estratégias de memória um. A cadeia pode ser redutível e ter mais de uma
+// distribuição estacionária (ex.: Tit-for-Tat contra Tit-for-Tat): a distribuição estacionária de
+// cada classe fechada é resolvida como sistema linear e pesada pela probabilidade de a cadeia,
+// partindo da primeira rodada, terminar nela
+func stationaryOutcome(a, b MemoryOne, m PayoffMatrix) (scoreA, scoreB, coopRate float64) {
+	// Transições do ponto de vista de A; para B, CD e DC se invertem
+	swap := [4]int{stateCC, stateDC, stateCD, stateDD}
+	var transition [4][4]float64
+	for state := 0; state < 4; state++ {
+		pA, pB := a.probs[state], b.probs[swap[state]]
+		transition[state] = [4]float64{pA * pB, pA * (1 - pB), (1 - pA) * pB, (1 - pA) * (1 - pB)}
+	}
+
+	// Distribuição da primeira rodada
+	pA, pB := a.initial, b.initial
+	initial := [4]float64{pA * pB, pA * (1 - pB), (1 - pA) * pB, (1 - pA) * (1 - pB)}
+
+	var average [4]float64
+	classes := closedClasses(transition)
+	for c, class := range classes {
+		h := absorption(transition, classes, c)
+		weight := 0.0
+		for state := 0; state < 4; state++ {
+			weight += initial[state] * h[state]
+		}
+		stationary := classStationary(transition, class)
+		for state := 0; state < 4; state++ {
+			average[state] += weight * stationary[state]
+		}
+	}
+
+	scoreA = average[stateCC]*float64(m.Reward) + average[stateCD]*float64(m.Sucker) +
+		average[stateDC]*float64(m.Temptation) + average[stateDD]*float64(m.Punishment)
+	scoreB = average[stateCC]*float64(m.Reward) + average[stateCD]*float64(m.Temptation) +
+		average[stateDC]*float64(m.Sucker) + average[stateDD]*float64(m.Punishment)
+	coopRate = average[stateCC] + (average[stateCD]+average[stateDC])/2
+	return scoreA, scoreB, coopRate
+}
+
+// memoryOnePrefix identifica o formato textual de uma estratégia de memória um
+const memoryOnePrefix = "mo:"
+
+// formatMemoryOne codifica a estratégia como "mo:pCC/pCD/pDC/pDD@inicial", para ser compartilhada
+func formatMemoryOne(s *MemoryOne) string {
+	parts := make([]string, len(s.probs))
+	for i, p := range s.probs {
+		parts[i] = strconv.FormatFloat(p, 'f', -1, 64)
+	}
+	return memoryOnePrefix + strings.Join(parts, "/") + "@" + strconv.FormatFloat(s.initial, 'f', -1, 64)
+}
+
+// parseMemoryOne lê uma estratégia no formato de formatMemoryOne, validando que todas as
+// probabilidades estão entre 0 e 1
+func parseMemoryOne(text string) (*MemoryOne, error) {
+	body, ok := strings.CutPrefix(strings.TrimSpace(text), memoryOnePrefix)
+	if !ok {
+		return nil, fmt.Errorf(tr("err_mo_format"), text)
+	}
+	probsText, initialText, ok := strings.Cut(body, "@")
+	fields := strings.Split(probsText, "/")
+	if !ok || len(fields) != 4 {
+		return nil, fmt.Errorf(tr("err_mo_format"), text)
+	}
+
+	values := make([]float64, 0, 5)
+	for _, field := range append(fields, initialText) {
+		p, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, fmt.Errorf(tr("err_mo_format"), text)
+		}
+		if p < 0 || p > 1 {
+			return nil, fmt.Errorf(tr("err_mo_range"), field)
+		}
+		values = append(values, p)
+	}
+	return NewMemoryOne(values[0], values[1], values[2], values[3], values[4]), nil
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// simulatedOutcome joga as duas estratégias de memória um por rounds rodadas e retorna a pontuação
+// média por rodada de cada uma e a taxa de cooperação
+func simulatedOutcome(a, b MemoryOne, m PayoffMatrix, rounds int, seed int64) (scoreA, scoreB, coopRate float64) {
+	game := NewGame(&a, &b, rounds)
+	game.SetPayoff(m)
+	game.SeedStrategies(seed)
+	for round := 0; round < rounds; round++ {
+		game.PlayRound(round)
+	}
+	coops := countMoves(game.movesA, Cooperate) + countMoves(game.movesB, Cooperate)
+	return float64(game.scores[0]) / float64(rounds), float64(game.scores[1]) / float64(rounds),
+		float64(coops) / float64(2*rounds)
+}
+
+func TestStationaryOutcomeMatchesSimulation(t *testing.T) {
+	const rounds = 200000
+	classic := PayoffMatrix{Reward: 3, Sucker: 0, Temptation: 5, Punishment: 1}
+	cases := []struct {
+		name string
+		a, b MemoryOne
+		m    PayoffMatrix
+	}{
+		{"generosa contra aleatória", *NewMemoryOne(1, 0.3, 1, 0.3, 1), Random{}.AsMemoryOne(), defaultPayoff},
+		{"Joss contra Stein", Joss{}.AsMemoryOne(), SteinRapoport{}.AsMemoryOne(), classic},
+		{"Pavlov ruidosa contra Tullock", *NewMemoryOne(0.9, 0.1, 0.1, 0.9, 1), Tullock{}.AsMemoryOne(), classic},
+		{"mistas", *NewMemoryOne(0.8, 0.2, 0.6, 0.4, 0.5), *NewMemoryOne(0.7, 0.1, 0.9, 0.3, 0.2), defaultPayoff},
+	}
+	for _, c := range cases {
+		wantA, wantB, wantCoop := stationaryOutcome(c.a, c.b, c.m)
+		gotA, gotB, gotCoop := simulatedOutcome(c.a, c.b, c.m, rounds, 7)
+		if math.Abs(gotA-wantA) > 0.05 || math.Abs(gotB-wantB) > 0.05 || math.Abs(gotCoop-wantCoop) > 0.01 {
+			t.Errorf("%s: análise exata (%.3f, %.3f, %.3f), simulação (%.3f, %.3f, %.3f)",
+				c.name, wantA, wantB, wantCoop, gotA, gotB, gotCoop)
+		}
+	}
+}
+
+func TestStationaryOutcomeReducibleChains(t *testing.T) {
+	m := defaultPayoff
+	cases := []struct {
+		name                   string
+		a, b                   MemoryOne
+		wantA, wantB, wantCoop float64
+	}{
+		// CC é absorvente: as duas cooperam para sempre
+		{"TFT contra TFT", TitForTat{}.AsMemoryOne(), TitForTat{}.AsMemoryOne(), float64(m.Reward), float64(m.Reward), 1},
+		// Depois da primeira rodada a cadeia fica presa em DD
+		{"TFT contra AllD", TitForTat{}.AsMemoryOne(), AlwaysDefect{}.AsMemoryOne(), float64(m.Punishment), float64(m.Punishment), 0},
+		// Começando em CD, TFT contra TFT alterna CD e DC para sempre (cadeia periódica)
+		{"TFT alternada", TitForTat{}.AsMemoryOne(), MemoryOne{probs: [4]float64{1, 0, 1, 0}, initial: 0},
+			float64(m.Sucker+m.Temptation) / 2, float64(m.Sucker+m.Temptation) / 2, 0.5},
+	}
+	for _, c := range cases {
+		gotA, gotB, gotCoop := stationaryOutcome(c.a, c.b, m)
+		if math.Abs(gotA-c.wantA) > 1e-9 || math.Abs(gotB-c.wantB) > 1e-9 || math.Abs(gotCoop-c.wantCoop) > 1e-9 {
+			t.Errorf("%s: (%.6f, %.6f, %.6f), esperado (%.6f, %.6f, %.6f)",
+				c.name, gotA, gotB, gotCoop, c.wantA, c.wantB, c.wantCoop)
+		}
+	}
+}
+
+func TestParsePayoff(t *testing.T) {
+	m, err := parsePayoff(" 3, 0,5 ,1")
+	if err != nil || m != (PayoffMatrix{Reward: 3, Sucker: 0, Temptation: 5, Punishment: 1}) {
+		t.Errorf("parsePayoff = %+v, %v", m, err)
+	}
+	if back, err := parsePayoff(formatPayoff(defaultPayoff)); err != nil || back != defaultPayoff {
+		t.Errorf("ida e volta da matriz padrão = %+v, %v", back, err)
+	}
+	for _, text := range []string{"", "3, 0, 5", "3, 0, x, 1", "5, 0, 3, 1", "3, 1, 5, 0"} {
+		if _, err := parsePayoff(text); err == nil {
+			t.Errorf("parsePayoff(%q) deveria falhar", text)
+		}
+	}
+}
+
+func TestMemoryOneRoundTrip(t *testing.T) {
+	for _, s := range []*MemoryOne{
+		NewMemoryOne(1, 0, 1, 0, 1),
+		NewMemoryOne(0.9, 0.1, 0.75, 0.333, 0.5),
+		NewMemoryOne(0, 0, 0, 0, 0),
+	} {
+		text := formatMemoryOne(s)
+		parsed, err := parseMemoryOne(text)
+		if err != nil {
+			t.Errorf("%s: %v", text, err)
+			continue
+		}
+		if parsed.probs != s.probs || parsed.initial != s.initial {
+			t.Errorf("%s lida como %v@%v", text, parsed.probs, parsed.initial)
+		}
+	}
+	// Espaços em volta são ignorados
+	if _, err := parseMemoryOne("  mo:1/0/1/0@1\n"); err != nil {
+		t.Errorf("com espaços: %v", err)
+	}
+}
+
+func TestParseMemoryOneErrors(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"1/0/1/0@1", fmt.Sprintf(tr("err_mo_format"), "1/0/1/0@1")},
+		{"mo:1/0/1@1", fmt.Sprintf(tr("err_mo_format"), "mo:1/0/1@1")},
+		{"mo:1/0/1/0", fmt.Sprintf(tr("err_mo_format"), "mo:1/0/1/0")},
+		{"mo:1/0/x/0@1", fmt.Sprintf(tr("err_mo_format"), "mo:1/0/x/0@1")},
+		{"mo:1/0/1.5/0@1", fmt.Sprintf(tr("err_mo_range"), "1.5")},
+		{"mo:1/0/1/0@-0.1", fmt.Sprintf(tr("err_mo_range"), "-0.1")},
+	}
+	for _, tt := range tests {
+		_, err := parseMemoryOne(tt.text)
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("%q: erro %v, esperado %q", tt.text, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// MatchSummary é uma linha (JSON) do histórico de partidas do modo normal, com o necessário para
+// rejogar a partida: as estratégias, a configuração e a semente dos geradores das estratégias
+type MatchSummary struct {
+	Timestamp     time.Time     `json:"data"`
+	StrategyA     string        `json:"estrategia_a"`
+	StrategyB     string        `json:"estrategia_b"`
+	Rounds        int           `json:"rodadas"`
+	Played        int           `json:"rodadas_jogadas"`
+	Seed          int64         `json:"semente"`
+	Payoff        *PayoffMatrix `json:"matriz,omitempty"` // Ausente quando é a matriz padrão
+	CoopBonus     int           `json:"bonus_cooperacao,omitempty"`
+	Escalation    int           `json:"penalidade_traicao,omitempty"`
+	HistoryWindow int           `json:"janela_historico,omitempty"`
+	StartA        int           `json:"pontuacao_inicial_a,omitempty"`
+	StartB        int           `json:"pontuacao_inicial_b,omitempty"`
+	Target        int           `json:"pontuacao_alvo,omitempty"`
+	ScoreA        int           `json:"pontuacao_a"`
+	ScoreB        int           `json:"pontuacao_b"`
+}
+
+// appendMatchLog acrescenta record ao fim do histórico em path, criando o arquivo se preciso
+func appendMatchLog(path string, record MatchSummary) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(f).Encode(record); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// readMatchLog lê o histórico em path, na ordem em que as partidas foram jogadas. Um histórico
+// inexistente é vazio; uma linha inválida é um erro, com o número dela
+func readMatchLog(path string) ([]MatchSummary, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []MatchSummary
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var record MatchSummary
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf(tr("err_history_line"), line, err)
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
+
+// replayMatch joga de novo a partida registrada em record, com a mesma configuração e semente.
+// Só estratégias do registro podem ser recriadas pelo nome
+func replayMatch(record MatchSummary) (*Game, error) {
+	strategyA, strategyB := newStrategy(record.StrategyA), newStrategy(record.StrategyB)
+	if strategyA == nil {
+		return nil, fmt.Errorf(tr("err_history_strategy"), record.StrategyA)
+	}
+	if strategyB == nil {
+		return nil, fmt.Errorf(tr("err_history_strategy"), record.StrategyB)
+	}
+
+	game := NewGame(strategyA, strategyB, record.Rounds)
+	if record.Payoff != nil {
+		game.SetPayoff(*record.Payoff)
+	}
+	game.SetCooperationBonus(record.CoopBonus)
+	game.SetDefectionEscalation(record.Escalation)
+	game.SetHistoryWindow(record.HistoryWindow)
+	game.SetStartingScores(record.StartA, record.StartB)
+	game.SeedStrategies(record.Seed)
+	game.RunUntilScore(record.Target, record.Rounds)
+	return game, nil
+}
+
+// summarizeMatch monta o registro do histórico de uma partida terminada
+func summarizeMatch(game *Game, seed int64, target int) MatchSummary {
+	summary := MatchSummary{
+		Timestamp:     time.Now(),
+		StrategyA:     game.strategyA.Name(),
+		StrategyB:     game.strategyB.Name(),
+		Rounds:        game.rounds,
+		Played:        len(game.movesA),
+		Seed:          seed,
+		CoopBonus:     game.coopBonus,
+		Escalation:    game.defectEscalation,
+		HistoryWindow: game.historyWindow,
+		StartA:        game.handicap[0],
+		StartB:        game.handicap[1],
+		Target:        target,
+		ScoreA:        game.scores[0],
+		ScoreB:        game.scores[1],
+	}
+	if game.payoff != defaultPayoff {
+		payoff := game.payoff
+		summary.Payoff = &payoff
+	}
+	return summary
+}
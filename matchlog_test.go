@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMatchLogRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "historico.jsonl")
+	if records, err := readMatchLog(path); err != nil || records != nil {
+		t.Fatalf("histórico inexistente: %v, %v", records, err)
+	}
+
+	// Uma partida com a configuração padrão e outra com matriz, janela e pontuação inicial próprias
+	first := NewGame(newStrategy("Joss"), newStrategy("Tit-for-Tat"), 50)
+	first.SeedStrategies(7)
+	first.RunUntilScore(0, 50)
+	second := NewGame(newStrategy("Random"), newStrategy("Friedman"), 40)
+	second.SetPayoff(PayoffMatrix{Reward: 4, Sucker: 0, Temptation: 6, Punishment: 2})
+	second.SetHistoryWindow(3)
+	second.SetStartingScores(5, 0)
+	second.SeedStrategies(8)
+	second.RunUntilScore(0, 40)
+	want := []MatchSummary{summarizeMatch(first, 7, 0), summarizeMatch(second, 8, 0)}
+	for _, record := range want {
+		if err := appendMatchLog(path, record); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	records, err := readMatchLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != len(want) {
+		t.Fatalf("%d partidas lidas, esperado %d", len(records), len(want))
+	}
+	for i := range want {
+		// O horário volta do JSON sem o relógio monotônico, então é comparado à parte
+		if !records[i].Timestamp.Equal(want[i].Timestamp) {
+			t.Errorf("partida %d: horário %v, esperado %v", i, records[i].Timestamp, want[i].Timestamp)
+		}
+		records[i].Timestamp = want[i].Timestamp
+		if !reflect.DeepEqual(records[i], want[i]) {
+			t.Errorf("partida %d: lida %+v, esperado %+v", i, records[i], want[i])
+		}
+		// O registro basta para rejogar a partida com o mesmo resultado
+		game, err := replayMatch(records[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if game.scores != [2]int{want[i].ScoreA, want[i].ScoreB} {
+			t.Errorf("partida %d rejogada: %v, esperado %d a %d", i, game.scores, want[i].ScoreA, want[i].ScoreB)
+		}
+	}
+	if records[0].Payoff != nil || records[1].Payoff == nil {
+		t.Errorf("matrizes gravadas: %v e %v, esperado só a da segunda partida", records[0].Payoff, records[1].Payoff)
+	}
+
+	// Uma linha inválida é informada pelo número
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("{quebrado\n")
+	f.Close()
+	if _, err := readMatchLog(path); err == nil || !strings.HasPrefix(err.Error(), fmt.Sprintf(tr("err_history_line"), 3, "")) {
+		t.Errorf("erro %v, esperado a linha 3", err)
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Upset é uma vitória no confronto direto de uma estratégia pior classificada sobre uma melhor
+type Upset struct {
+	winner, loser         string
+	winnerRank, loserRank int
+	margin                float64 // Diferença de pontos por jogo no confronto direto
+}
+
+// TournamentStats reúne as estatísticas de um torneio usadas para narrar o resultado
+type TournamentStats struct {
+	reps        int                // Repetições do torneio
+	means       map[string]float64 // Pontuação média de cada estratégia nas repetições
+	margins     map[string]float64 // Margem do intervalo de confiança de 95% de cada estratégia (com reps > 1)
+	upset       *Upset             // Maior surpresa no confronto direto, se houver
+	cooperation float64            // Fração de jogadas cooperativas em todo o torneio
+	winnerNice  bool               // A vencedora nunca foi a primeira a trair
+	winnerCoop  float64            // Fração de jogadas cooperativas da vencedora
+	names       []string           // Estratégias na ordem da matriz de confrontos
+	settings    string             // Resumo da configuração que produziu o torneio (veja tournamentSettings)
+}
+
+// tournamentStats calcula as estatísticas do torneio a partir da classificação, da matriz de
+// confrontos (com os nomes e cópias na ordem da matriz) e das pontuações de cada repetição
+func tournamentStats(results []Result, matrix [][]int, names []string, counts []int, samples map[string][]float64, reps int) TournamentStats {
+	stats := TournamentStats{reps: reps, means: make(map[string]float64), margins: make(map[string]float64), names: names}
+	for name, scores := range samples {
+		stats.means[name], stats.margins[name] = confidenceInterval95(scores)
+	}
+
+	// Cooperação geral, ponderada pelo número de jogos de cada estratégia
+	games := 0
+	for _, result := range results {
+		stats.cooperation += result.coopRate * float64(result.games)
+		games += result.games
+	}
+	if games > 0 {
+		stats.cooperation /= float64(games)
+	}
+	if len(results) > 0 {
+		stats.winnerNice, stats.winnerCoop = results[0].nice, results[0].coopRate
+	}
+
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[name] = i
+	}
+	// A maior surpresa é a maior vantagem por jogo de uma estratégia sobre outra mais bem classificada
+	for _, better := range results {
+		for _, worse := range results {
+			if worse.rank <= better.rank {
+				continue
+			}
+			i, j := index[worse.name], index[better.name]
+			if counts[i] == 0 || counts[j] == 0 {
+				continue
+			}
+			margin := pointsPerGame(matrix, counts, i, j) - pointsPerGame(matrix, counts, j, i)
+			if margin > 0 && (stats.upset == nil || margin > stats.upset.margin) {
+				stats.upset = &Upset{winner: worse.name, loser: better.name,
+					winnerRank: worse.rank, loserRank: better.rank, margin: margin}
+			}
+		}
+	}
+	return stats
+}
+
+// narrateTournament gera um parágrafo curto explicando o resultado do torneio: quem venceu, se
+// as estratégias gentis dominaram, se a aleatoriedade pode ter decidido e a maior surpresa
+func narrateTournament(stats TournamentStats, results []Result) string {
+	if len(results) == 0 {
+		return ""
+	}
+	var sentences []string
+
+	winner := results[0]
+	if len(results) > 1 {
+		second := results[1]
+		sentences = append(sentences, fmt.Sprintf(tr("narrative_winner"),
+			winner.name, winner.score, winner.score-second.score, second.name))
+	} else {
+		sentences = append(sentences, fmt.Sprintf(tr("narrative_only"), winner.name, winner.score))
+	}
+
+	// Gentis na metade de cima da classificação (arredondada para cima)
+	top := (len(results) + 1) / 2
+	nice := 0
+	for _, result := range results[:top] {
+		if result.nice {
+			nice++
+		}
+	}
+	if 2*nice > top {
+		sentences = append(sentences, fmt.Sprintf(tr("narrative_nice_won"), nice, top))
+	} else {
+		sentences = append(sentences, fmt.Sprintf(tr("narrative_nasty_won"), nice, top))
+	}
+
+	// Com repetições, verifica se os intervalos de confiança dos dois primeiros se sobrepõem
+	if stats.reps > 1 && len(results) > 1 {
+		second := results[1]
+		gap := stats.means[winner.name] - stats.means[second.name]
+		if gap <= stats.margins[winner.name]+stats.margins[second.name] {
+			sentences = append(sentences, fmt.Sprintf(tr("narrative_noise_high"), stats.reps))
+		} else {
+			sentences = append(sentences, fmt.Sprintf(tr("narrative_noise_low"), stats.reps))
+		}
+	}
+
+	if upset := stats.upset; upset != nil {
+		sentences = append(sentences, fmt.Sprintf(tr("narrative_upset"),
+			upset.winner, upset.winnerRank, upset.loser, upset.loserRank, upset.margin))
+	}
+	return strings.Join(sentences, " ")
+}
+
+// Limiares da classificação do torneio pela cooperação geral
+const (
+	emergenceCooperation = 0.6 // A partir daqui, com uma vencedora gentil, a cooperação emergiu
+	dominanceCooperation = 0.4 // Até aqui, a traição dominou
+)
+
+// classifyTournament resume o resultado do torneio em um rótulo: a cooperação emergiu (muita
+// cooperação e uma vencedora gentil), a traição dominou (pouca cooperação, ou uma vencedora que
+// trai primeiro e coopera menos da metade das vezes) ou misto
+func classifyTournament(stats TournamentStats) string {
+	switch {
+	case stats.cooperation >= emergenceCooperation && stats.winnerNice:
+		return tr("class_cooperation")
+	case stats.cooperation <= dominanceCooperation || (!stats.winnerNice && stats.winnerCoop < 0.5):
+		return tr("class_defection")
+	}
+	return tr("class_mixed")
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestClassifyTournament(t *testing.T) {
+	cases := []struct {
+		name  string
+		stats TournamentStats
+		want  string
+	}{
+		{"muita cooperação e vencedora gentil", TournamentStats{cooperation: 0.8, winnerNice: true, winnerCoop: 0.9}, "class_cooperation"},
+		{"no limiar da emergência", TournamentStats{cooperation: emergenceCooperation, winnerNice: true, winnerCoop: 0.7}, "class_cooperation"},
+		{"pouca cooperação", TournamentStats{cooperation: 0.2, winnerNice: true, winnerCoop: 0.9}, "class_defection"},
+		{"no limiar da dominação", TournamentStats{cooperation: dominanceCooperation, winnerNice: true, winnerCoop: 0.9}, "class_defection"},
+		{"vencedora que trai muito", TournamentStats{cooperation: 0.7, winnerNice: false, winnerCoop: 0.3}, "class_defection"},
+		{"cooperação intermediária", TournamentStats{cooperation: 0.5, winnerNice: true, winnerCoop: 0.9}, "class_mixed"},
+		{"muita cooperação, vencedora que trai primeiro", TournamentStats{cooperation: 0.8, winnerNice: false, winnerCoop: 0.7}, "class_mixed"},
+	}
+	for _, c := range cases {
+		if got := classifyTournament(c.stats); got != tr(c.want) {
+			t.Errorf("%s: %q, esperado %q", c.name, got, tr(c.want))
+		}
+	}
+}
+
+func TestNarrateTournament(t *testing.T) {
+	if got := narrateTournament(TournamentStats{}, nil); got != "" {
+		t.Errorf("torneio vazio narrado: %q", got)
+	}
+
+	only := narrateTournament(TournamentStats{reps: 1}, []Result{{name: "Tit-for-Tat", score: 30, nice: true, rank: 1}})
+	if want := fmt.Sprintf(tr("narrative_only"), "Tit-for-Tat", 30) + " " + fmt.Sprintf(tr("narrative_nice_won"), 1, 1); only != want {
+		t.Errorf("uma estratégia: %q, esperado %q", only, want)
+	}
+
+	results := []Result{
+		{name: "Tit-for-Tat", score: 300, nice: true, rank: 1},
+		{name: "Always Defect", score: 280, rank: 2},
+		{name: "Grudger", score: 250, nice: true, rank: 3},
+		{name: "Random", score: 200, rank: 4},
+	}
+	upset := &Upset{winner: "Always Defect", loser: "Tit-for-Tat", winnerRank: 2, loserRank: 1, margin: 1}
+	cases := []struct {
+		name  string
+		stats TournamentStats
+		want  []string
+	}{
+		{"sem repetições", TournamentStats{reps: 1}, []string{
+			fmt.Sprintf(tr("narrative_winner"), "Tit-for-Tat", 300, 20, "Always Defect"),
+			fmt.Sprintf(tr("narrative_nasty_won"), 1, 2),
+		}},
+		// Os intervalos se sobrepõem: 10 de diferença e 6+6 de margem
+		{"ruído alto", TournamentStats{reps: 5,
+			means:   map[string]float64{"Tit-for-Tat": 300, "Always Defect": 290},
+			margins: map[string]float64{"Tit-for-Tat": 6, "Always Defect": 6}}, []string{
+			fmt.Sprintf(tr("narrative_winner"), "Tit-for-Tat", 300, 20, "Always Defect"),
+			fmt.Sprintf(tr("narrative_nasty_won"), 1, 2),
+			fmt.Sprintf(tr("narrative_noise_high"), 5),
+		}},
+		{"ruído baixo com surpresa", TournamentStats{reps: 5, upset: upset,
+			means:   map[string]float64{"Tit-for-Tat": 300, "Always Defect": 280},
+			margins: map[string]float64{"Tit-for-Tat": 2, "Always Defect": 3}}, []string{
+			fmt.Sprintf(tr("narrative_winner"), "Tit-for-Tat", 300, 20, "Always Defect"),
+			fmt.Sprintf(tr("narrative_nasty_won"), 1, 2),
+			fmt.Sprintf(tr("narrative_noise_low"), 5),
+			fmt.Sprintf(tr("narrative_upset"), "Always Defect", 2, "Tit-for-Tat", 1, 1.0),
+		}},
+	}
+	for _, c := range cases {
+		if got, want := narrateTournament(c.stats, results), strings.Join(c.want, " "); got != want {
+			t.Errorf("%s: %q, esperado %q", c.name, got, want)
+		}
+	}
+
+	// Com as duas primeiras gentis, as gentis são maioria na metade de cima
+	results[1].nice = true
+	if got := narrateTournament(TournamentStats{reps: 1}, results); !strings.Contains(got, fmt.Sprintf(tr("narrative_nice_won"), 2, 2)) {
+		t.Errorf("gentis no topo não narradas: %q", got)
+	}
+}
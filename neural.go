@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// neuralMemory é o número de rodadas anteriores, de cada jogador, que entram na rede
+const neuralMemory = 3
+
+// neuralInputs é o tamanho da entrada da rede: as jogadas das últimas neuralMemory rodadas dos
+// dois jogadores e a fração do jogo já disputada
+const neuralInputs = 2*neuralMemory + 1
+
+// NeuralWeights são os pesos de uma rede de uma camada oculta (tanh) e uma saída linear. Cada
+// linha de Hidden tem um peso por entrada e, por último, o viés; Output tem um peso por neurônio
+// oculto e, por último, o viés
+type NeuralWeights struct {
+	Hidden [][]float64 `json:"oculta"`
+	Output []float64   `json:"saida"`
+}
+
+// titForTatWeights é uma rede que reproduz Tit-for-Tat: um único neurônio oculto que só olha a
+// última jogada do oponente
+var titForTatWeights = NeuralWeights{
+	Hidden: [][]float64{{0, 1, 0, 0, 0, 0, 0, -0.5}},
+	Output: []float64{1, 0},
+}
+
+// parseNeuralWeights lê os pesos em JSON, validando as dimensões da rede
+func parseNeuralWeights(data []byte) (NeuralWeights, error) {
+	var weights NeuralWeights
+	if err := json.Unmarshal(data, &weights); err != nil {
+		return NeuralWeights{}, fmt.Errorf(tr("err_neural_json"), err)
+	}
+	if len(weights.Hidden) == 0 {
+		return NeuralWeights{}, fmt.Errorf(tr("err_neural_shape"), "oculta", 1, 0)
+	}
+	for _, row := range weights.Hidden {
+		if len(row) != neuralInputs+1 {
+			return NeuralWeights{}, fmt.Errorf(tr("err_neural_shape"), "oculta", neuralInputs+1, len(row))
+		}
+	}
+	if len(weights.Output) != len(weights.Hidden)+1 {
+		return NeuralWeights{}, fmt.Errorf(tr("err_neural_shape"), "saida", len(weights.Hidden)+1, len(weights.Output))
+	}
+	return weights, nil
+}
+
+// NeuralStrategy: Decide com uma pequena rede neural de pesos fixos (sem treino). A entrada são,
+// para cada uma das últimas rodadas, a própria jogada e a do oponente (1 = trair, 0 = cooperar ou
+// rodada inexistente) e a fração do jogo já disputada; trai se a saída for positiva
+type NeuralStrategy struct {
+	ownHistory
+	weights NeuralWeights
+	horizon int
+}
+
+// NewNeuralStrategy cria a estratégia com os pesos dados (veja parseNeuralWeights)
+func NewNeuralStrategy(weights NeuralWeights) *NeuralStrategy {
+	return &NeuralStrategy{weights: weights}
+}
+
+// features monta a entrada da rede na rodada dada, alinhando os históricos pelo fim
+func (s *NeuralStrategy) features(round int, opponentMoves []Choice) []float64 {
+	input := make([]float64, neuralInputs)
+	value := func(moves []Choice, ago int) float64 {
+		if ago > len(moves) || moves[len(moves)-ago] == Cooperate {
+			return 0
+		}
+		return 1
+	}
+	for ago := 1; ago <= neuralMemory; ago++ {
+		input[2*(ago-1)] = value(s.ownMoves, ago)
+		input[2*(ago-1)+1] = value(opponentMoves, ago)
+	}
+	if s.horizon > 0 {
+		input[neuralInputs-1] = float64(round) / float64(s.horizon)
+	}
+	return input
+}
+
+// forward calcula a saída da rede para a entrada dada
+func (s *NeuralStrategy) forward(input []float64) float64 {
+	output := s.weights.Output[len(s.weights.Output)-1]
+	for i, row := range s.weights.Hidden {
+		sum := row[len(row)-1]
+		for k, x := range input {
+			sum += row[k] * x
+		}
+		output += s.weights.Output[i] * math.Tanh(sum)
+	}
+	return output
+}
+
+func (s *NeuralStrategy) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+	}
+	if s.forward(s.features(round, opponentMoves)) > 0 {
+		return s.play(Defect)
+	}
+	return s.play(Cooperate)
+}
+func (s *NeuralStrategy) Name() string          { return "Neural Net" }
+func (s *NeuralStrategy) Reset()                { s.ownMoves = s.ownMoves[:0] }
+func (s *NeuralStrategy) SetHorizon(rounds int) { s.horizon = rounds }
+func (s *NeuralStrategy) Clone() Strategy       { return NewNeuralStrategy(s.weights) }
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestNeuralTitForTatWeightsReproduceTitForTat(t *testing.T) {
+	forbidGlobalRNG(t)
+	opponents := []Strategy{AlwaysDefect{}, AlwaysCooperate{}, Grofman{}, periodicDefector{3}, &Joss{}, &biasedRandom{p: 0.5}}
+	for _, opponent := range opponents {
+		for seed := int64(0); seed < 3; seed++ {
+			neural := playMatch(t, NewNeuralStrategy(titForTatWeights), freshInstance(opponent), 60, seed)
+			reference := playMatch(t, TitForTat{}, freshInstance(opponent), 60, seed)
+			if got, want := movesString(neural.movesA), movesString(reference.movesA); got != want {
+				t.Errorf("contra %s (semente %d): a rede jogou %s, Tit-for-Tat %s", opponent.Name(), seed, got, want)
+			}
+		}
+	}
+}
+
+func TestParseNeuralWeights(t *testing.T) {
+	data, err := json.Marshal(titForTatWeights)
+	if err != nil {
+		t.Fatal(err)
+	}
+	weights, err := parseNeuralWeights(data)
+	if err != nil || !reflect.DeepEqual(weights, titForTatWeights) {
+		t.Errorf("pesos lidos %+v (%v), esperado %+v", weights, err, titForTatWeights)
+	}
+
+	tests := []struct {
+		json string
+		want string
+	}{
+		{`{"oculta": [], "saida": [0]}`, fmt.Sprintf(tr("err_neural_shape"), "oculta", 1, 0)},
+		{`{"oculta": [[1, 2]], "saida": [1, 0]}`, fmt.Sprintf(tr("err_neural_shape"), "oculta", neuralInputs+1, 2)},
+		{`{"oculta": [[0, 1, 0, 0, 0, 0, 0, 0]], "saida": [1]}`, fmt.Sprintf(tr("err_neural_shape"), "saida", 2, 1)},
+	}
+	for _, tt := range tests {
+		if _, err := parseNeuralWeights([]byte(tt.json)); err == nil || err.Error() != tt.want {
+			t.Errorf("%s: erro %v, esperado %q", tt.json, err, tt.want)
+		}
+	}
+	if _, err := parseNeuralWeights([]byte("{")); err == nil {
+		t.Error("JSON inválido aceito")
+	}
+}
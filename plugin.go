@@ -0,0 +1,89 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"plugin"
+	"strings"
+)
+
+// pluginSymbol é o nome da função que um plugin de estratégia deve exportar
+const pluginSymbol = "NewStrategy"
+
+// PluginStrategy é o que o NewStrategy de um plugin deve retornar. Como um plugin não pode
+// importar o pacote main, as jogadas usam int (0 = cooperar, 1 = trair) em vez de Choice:
+//
+//	package main
+//
+//	type alwaysCooperate struct{}
+//
+//	func (alwaysCooperate) Name() string                                { return "Plugin Cooperator" }
+//	func (alwaysCooperate) NextMove(round int, opponentMoves []int) int { return 0 }
+//
+//	func NewStrategy() any { return alwaysCooperate{} }
+//
+// compilado com: go build -buildmode=plugin -o cooperator.so
+type PluginStrategy interface {
+	Name() string
+	NextMove(round int, opponentMoves []int) int
+}
+
+// pluginAdapter adapta uma PluginStrategy à interface Strategy
+type pluginAdapter struct {
+	inner PluginStrategy
+}
+
+func (s pluginAdapter) NextMove(round int, opponentMoves []Choice) Choice {
+	moves := make([]int, len(opponentMoves))
+	for i, move := range opponentMoves {
+		moves[i] = int(move)
+	}
+	// Qualquer valor diferente de 0 conta como traição
+	if s.inner.NextMove(round, moves) != int(Cooperate) {
+		return Defect
+	}
+	return Cooperate
+}
+func (s pluginAdapter) Name() string { return s.inner.Name() }
+
+// LoadStrategyPlugin abre o plugin em path, verifica que ele exporta NewStrategy com a assinatura
+// esperada e registra a estratégia, retornando uma instância dela
+func LoadStrategyPlugin(path string) (Strategy, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		// Plugins só carregam se compilados com a mesma versão do Go e das dependências
+		if strings.Contains(err.Error(), "different version") {
+			return nil, fmt.Errorf(tr("err_plugin_version"), err)
+		}
+		return nil, fmt.Errorf(tr("err_plugin_open"), err)
+	}
+	symbol, err := p.Lookup(pluginSymbol)
+	if err != nil {
+		return nil, fmt.Errorf(tr("err_plugin_symbol"), pluginSymbol)
+	}
+	newFn, ok := symbol.(func() any)
+	if !ok {
+		return nil, fmt.Errorf(tr("err_plugin_signature"), pluginSymbol)
+	}
+
+	// Cada chamada a NewStrategy deve criar uma instância nova, para não compartilhar estado entre jogos
+	newStrategyFn := func() (Strategy, error) {
+		inner, ok := newFn().(PluginStrategy)
+		if !ok {
+			return nil, errors.New(tr("err_plugin_type"))
+		}
+		return pluginAdapter{inner: inner}, nil
+	}
+	s, err := newStrategyFn()
+	if err != nil {
+		return nil, err
+	}
+	if newStrategy(s.Name()) != nil {
+		return nil, fmt.Errorf(tr("err_plugin_duplicate"), s.Name())
+	}
+	strategyRegistry = append(strategyRegistry, func() Strategy {
+		fresh, _ := newStrategyFn()
+		return fresh
+	})
+	return s, nil
+}
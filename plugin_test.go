@@ -0,0 +1,94 @@
+//go:build plugintest
+
+// Os testes de plugin compilam plugins de verdade com o go da máquina, o que exige Linux ou macOS
+// com cgo; rode com: go test -tags plugintest -run Plugin
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// buildPlugin compila o código de um plugin e retorna o caminho do .so
+func buildPlugin(t *testing.T, name, source string) string {
+	t.Helper()
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go não encontrado no PATH")
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name+".so")
+	cmd := exec.Command(gobin, "build", "-buildmode=plugin", "-o", path, "main.go")
+	cmd.Dir = dir
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("compilando o plugin %s: %v\n%s", name, err, output)
+	}
+	return path
+}
+
+const cooperatorPlugin = `package main
+
+type alwaysCooperate struct{}
+
+func (alwaysCooperate) Name() string                                { return "Plugin Cooperator" }
+func (alwaysCooperate) NextMove(round int, opponentMoves []int) int { return 0 }
+
+func NewStrategy() any { return alwaysCooperate{} }
+`
+
+func TestLoadStrategyPlugin(t *testing.T) {
+	defer func(registry []func() Strategy) { strategyRegistry = registry }(strategyRegistry)
+
+	s, err := LoadStrategyPlugin(buildPlugin(t, "cooperator", cooperatorPlugin))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Name() != "Plugin Cooperator" {
+		t.Errorf("nome %q, esperado Plugin Cooperator", s.Name())
+	}
+	game := playMatch(t, s, AlwaysDefect{}, 10, 1)
+	if got := movesString(game.movesA); got != "CCCCCCCCCC" {
+		t.Errorf("o plugin jogou %s, esperado só cooperação", got)
+	}
+	// A estratégia fica registrada e pode ser criada pelo nome, como as nativas
+	if newStrategy("Plugin Cooperator") == nil {
+		t.Error("a estratégia do plugin não foi registrada")
+	}
+}
+
+func TestLoadStrategyPluginErrors(t *testing.T) {
+	defer func(registry []func() Strategy) { strategyRegistry = registry }(strategyRegistry)
+
+	tests := []struct {
+		name   string
+		source string
+		want   error
+	}{
+		{"sem_simbolo", "package main\n\nfunc Other() any { return nil }\n",
+			fmt.Errorf(tr("err_plugin_symbol"), pluginSymbol)},
+		{"assinatura", "package main\n\nfunc NewStrategy() int { return 0 }\n",
+			fmt.Errorf(tr("err_plugin_signature"), pluginSymbol)},
+		{"tipo", "package main\n\nfunc NewStrategy() any { return 42 }\n",
+			errors.New(tr("err_plugin_type"))},
+		{"duplicada", "package main\n\ntype tft struct{}\n\nfunc (tft) Name() string { return \"Tit-for-Tat\" }\n" +
+			"func (tft) NextMove(round int, opponentMoves []int) int { return 0 }\n\nfunc NewStrategy() any { return tft{} }\n",
+			fmt.Errorf(tr("err_plugin_duplicate"), "Tit-for-Tat")},
+	}
+	for _, tt := range tests {
+		_, err := LoadStrategyPlugin(buildPlugin(t, tt.name, tt.source))
+		if err == nil || err.Error() != tt.want.Error() {
+			t.Errorf("%s: erro %v, esperado %q", tt.name, err, tt.want)
+		}
+	}
+	if _, err := LoadStrategyPlugin(filepath.Join(t.TempDir(), "inexistente.so")); err == nil {
+		t.Error("carregou um plugin que não existe")
+	}
+}
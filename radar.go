@@ -0,0 +1,139 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Aparência do gráfico de radar
+const (
+	radarRings      = 4   // Círculos de referência (em 25%, 50%, 75% e 100%)
+	radarMinSize    = 320 // Tamanho mínimo do gráfico, em pixels
+	radarLabelSpace = 60  // Espaço reservado para os rótulos dos eixos
+	radarLegendRow  = 18  // Altura de cada linha da legenda
+)
+
+// radarPalette são as cores atribuídas, em ordem, às séries do gráfico
+var radarPalette = []color.Color{
+	color.RGBA{R: 0x1f, G: 0x77, B: 0xb4, A: 0xff},
+	color.RGBA{R: 0xd6, G: 0x27, B: 0x28, A: 0xff},
+	color.RGBA{R: 0x2c, G: 0xa0, B: 0x2c, A: 0xff},
+	color.RGBA{R: 0xff, G: 0x7f, B: 0x0e, A: 0xff},
+	color.RGBA{R: 0x94, G: 0x67, B: 0xbd, A: 0xff},
+	color.RGBA{R: 0x8c, G: 0x56, B: 0x4b, A: 0xff},
+}
+
+// RadarSeries é um polígono do gráfico de radar, com um valor entre 0 e 1 para cada eixo
+type RadarSeries struct {
+	label  string
+	values []float64
+}
+
+// RadarChart é um gráfico de radar (teia de aranha) com eixos rotulados e várias séries sobrepostas
+type RadarChart struct {
+	widget.BaseWidget
+	axes   []string
+	series []RadarSeries
+}
+
+// NewRadarChart cria um gráfico de radar vazio com os eixos informados
+func NewRadarChart(axes []string) *RadarChart {
+	chart := &RadarChart{axes: axes}
+	chart.ExtendBaseWidget(chart)
+	return chart
+}
+
+// SetSeries substitui as séries exibidas e redesenha o gráfico
+func (r *RadarChart) SetSeries(series []RadarSeries) {
+	r.series = series
+	r.Refresh()
+}
+
+func (r *RadarChart) CreateRenderer() fyne.WidgetRenderer {
+	return &radarRenderer{chart: r}
+}
+
+// radarRenderer recria as linhas e os textos do gráfico a cada mudança de tamanho ou de séries
+type radarRenderer struct {
+	chart   *RadarChart
+	objects []fyne.CanvasObject
+}
+
+func (r *radarRenderer) Layout(size fyne.Size) {
+	r.objects = r.objects[:0]
+	axes := len(r.chart.axes)
+	if axes < 3 {
+		return
+	}
+
+	legendHeight := float32(len(r.chart.series) * radarLegendRow)
+	side := math.Min(float64(size.Width), float64(size.Height-legendHeight))
+	radius := float32(side/2 - radarLabelSpace)
+	if radius <= 0 {
+		return
+	}
+	center := fyne.NewPos(size.Width/2, float32(side/2))
+
+	// point converte (eixo, valor entre 0 e 1) em coordenadas; o primeiro eixo aponta para cima
+	point := func(axis int, value float64) fyne.Position {
+		angle := 2*math.Pi*float64(axis)/float64(axes) - math.Pi/2
+		return fyne.NewPos(center.X+radius*float32(value*math.Cos(angle)),
+			center.Y+radius*float32(value*math.Sin(angle)))
+	}
+	line := func(from, to fyne.Position, c color.Color, width float32) {
+		l := canvas.NewLine(c)
+		l.StrokeWidth = width
+		l.Position1, l.Position2 = from, to
+		r.objects = append(r.objects, l)
+	}
+
+	// Grade: círculos de referência, raios e rótulos dos eixos
+	grid := theme.Color(theme.ColorNameDisabled)
+	for ring := 1; ring <= radarRings; ring++ {
+		value := float64(ring) / radarRings
+		for k := 0; k < axes; k++ {
+			line(point(k, value), point((k+1)%axes, value), grid, 1)
+		}
+	}
+	for k, name := range r.chart.axes {
+		line(center, point(k, 1), grid, 1)
+		label := canvas.NewText(name, theme.Color(theme.ColorNameForeground))
+		label.TextSize = theme.CaptionTextSize()
+		labelSize := label.MinSize()
+		pos := point(k, 1.15)
+		label.Move(fyne.NewPos(pos.X-labelSize.Width/2, pos.Y-labelSize.Height/2))
+		r.objects = append(r.objects, label)
+	}
+
+	// Séries e legenda
+	for i, series := range r.chart.series {
+		c := radarPalette[i%len(radarPalette)]
+		for k := 0; k < axes && k < len(series.values); k++ {
+			next := (k + 1) % axes
+			if next >= len(series.values) {
+				break
+			}
+			line(point(k, series.values[k]), point(next, series.values[next]), c, 2)
+		}
+		legend := canvas.NewText("■ "+series.label, c)
+		legend.Move(fyne.NewPos(0, float32(side)+float32(i*radarLegendRow)))
+		r.objects = append(r.objects, legend)
+	}
+}
+
+func (r *radarRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(radarMinSize, radarMinSize+float32(len(r.chart.series)*radarLegendRow))
+}
+
+func (r *radarRenderer) Refresh() {
+	r.Layout(r.chart.Size())
+	canvas.Refresh(r.chart)
+}
+
+func (r *radarRenderer) Objects() []fyne.CanvasObject { return r.objects }
+func (r *radarRenderer) Destroy()                     {}
@@ -0,0 +1,63 @@
+package main
+
+// Reputation acumula, para cada estratégia, quantas jogadas cooperativas ela fez em todos
+// os jogos já disputados, permitindo experimentos de reciprocidade indireta
+type Reputation struct {
+	cooperations map[string]int
+	moves        map[string]int
+}
+
+// NewReputation cria um registro de reputação vazio
+func NewReputation() *Reputation {
+	return &Reputation{cooperations: make(map[string]int), moves: make(map[string]int)}
+}
+
+// Record contabiliza as jogadas que a estratégia name fez em um jogo
+func (r *Reputation) Record(name string, moves []Choice) {
+	for _, move := range moves {
+		if move == Cooperate {
+			r.cooperations[name]++
+		}
+	}
+	r.moves[name] += len(moves)
+}
+
+// Score retorna a fração de cooperação da estratégia name nos jogos anteriores; known é false
+// se ela ainda não jogou
+func (r *Reputation) Score(name string) (score float64, known bool) {
+	if r.moves[name] == 0 {
+		return 0, false
+	}
+	return float64(r.cooperations[name]) / float64(r.moves[name]), true
+}
+
+// ReputationAware é implementada por estratégias que consultam a reputação do oponente atual
+type ReputationAware interface {
+	SetOpponentReputation(score float64, known bool)
+}
+
+// informReputation passa à estratégia a reputação do oponente, se ela a consultar
+func informReputation(s Strategy, r *Reputation, opponent string) {
+	if aware, ok := s.(ReputationAware); ok {
+		aware.SetOpponentReputation(r.Score(opponent))
+	}
+}
+
+// ReputationStrategy: Coopera com oponentes de boa reputação (cooperaram ao menos threshold das
+// vezes nos jogos anteriores) e trai os de má reputação; dá o benefício da dúvida a desconhecidos
+type ReputationStrategy struct {
+	threshold  float64
+	reputation float64
+	known      bool
+}
+
+func (s *ReputationStrategy) NextMove(round int, opponentMoves []Choice) Choice {
+	if !s.known || s.reputation >= s.threshold {
+		return Cooperate
+	}
+	return Defect
+}
+func (s ReputationStrategy) Name() string { return "Reputation" }
+func (s *ReputationStrategy) SetOpponentReputation(score float64, known bool) {
+	s.reputation, s.known = score, known
+}
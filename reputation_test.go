@@ -0,0 +1,41 @@
+package main
+
+import "testing"
+
+func TestReputationAccumulatesAcrossGames(t *testing.T) {
+	r := NewReputation()
+	if _, known := r.Score("Alpha"); known {
+		t.Error("reputação conhecida antes de qualquer jogo")
+	}
+	r.Record("Alpha", parseMoves("CCCD"))
+	if score, known := r.Score("Alpha"); !known || score != 0.75 {
+		t.Errorf("depois de um jogo: %.2f (conhecida: %v), esperado 0.75", score, known)
+	}
+	// Os jogos se somam, ponderados pelo número de jogadas
+	r.Record("Alpha", parseMoves("DDDDDDDDDDDD"))
+	if score, _ := r.Score("Alpha"); score != 3.0/16 {
+		t.Errorf("depois de dois jogos: %.4f, esperado %.4f", score, 3.0/16)
+	}
+	// Cada estratégia tem a sua reputação
+	r.Record("Beta", parseMoves("CC"))
+	if score, _ := r.Score("Beta"); score != 1 {
+		t.Errorf("reputação de Beta: %.2f, esperado 1", score)
+	}
+
+	// ReputationStrategy trai quem ficou abaixo do limite e coopera com quem não é conhecido
+	tests := []struct {
+		opponent string
+		want     Choice
+	}{
+		{"Alpha", Defect},
+		{"Beta", Cooperate},
+		{"Gamma", Cooperate},
+	}
+	for _, tt := range tests {
+		s := &ReputationStrategy{threshold: 0.5}
+		informReputation(s, r, tt.opponent)
+		if got := s.NextMove(0, nil); got != tt.want {
+			t.Errorf("contra %s: %s, esperado %s", tt.opponent, moveCode(got), moveCode(tt.want))
+		}
+	}
+}
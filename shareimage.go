@@ -0,0 +1,117 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Dimensões da imagem de resultados para compartilhar; a altura cresce com o número de linhas
+const (
+	shareWidth      = 800
+	shareMargin     = 20
+	shareLineHeight = 16
+	shareCharWidth  = 7 // Largura de cada caractere da fonte basicfont.Face7x13
+)
+
+// Cores da imagem: fundo, texto, título e legenda
+var (
+	shareBackground = color.White
+	shareText       = color.Black
+	shareTitle      = color.RGBA{R: 0x1f, G: 0x77, B: 0xb4, A: 0xff}
+	shareCaption    = color.RGBA{R: 0x55, G: 0x55, B: 0x55, A: 0xff}
+)
+
+// wrapText quebra text em linhas de no máximo width caracteres, nas quebras de linha e entre
+// palavras; palavras mais longas que width são cortadas e linhas em branco são mantidas. Texto
+// vazio não gera nenhuma linha
+func wrapText(text string, width int) []string {
+	if text == "" {
+		return nil
+	}
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		if strings.TrimSpace(paragraph) == "" {
+			lines = append(lines, "")
+			continue
+		}
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			for len([]rune(word)) > width {
+				if line != "" {
+					lines = append(lines, line)
+					line = ""
+				}
+				runes := []rune(word)
+				lines = append(lines, string(runes[:width]))
+				word = string(runes[width:])
+			}
+			switch {
+			case line == "":
+				line = word
+			case len([]rune(line))+1+len([]rune(word)) <= width:
+				line += " " + word
+			default:
+				lines = append(lines, line)
+				line = word
+			}
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// shareImageHeight retorna a altura da imagem com o número dado de linhas de texto
+func shareImageHeight(lines int) int {
+	return 2*shareMargin + lines*shareLineHeight
+}
+
+// renderResultsImage desenha os resultados em uma imagem fora da tela, independente do tema da
+// interface: o título, a legenda escrita pelo usuário (se houver) e as linhas de resultados
+func renderResultsImage(title, caption string, results []string) *image.RGBA {
+	width := (shareWidth - 2*shareMargin) / shareCharWidth
+	type textLine struct {
+		text  string
+		color color.Color
+	}
+	var lines []textLine
+	for _, line := range wrapText(title, width) {
+		lines = append(lines, textLine{line, shareTitle})
+	}
+	for _, line := range wrapText(caption, width) {
+		lines = append(lines, textLine{line, shareCaption})
+	}
+	lines = append(lines, textLine{"", shareText})
+	for _, result := range results {
+		if result == "" {
+			lines = append(lines, textLine{"", shareText})
+		}
+		for _, line := range wrapText(result, width) {
+			lines = append(lines, textLine{line, shareText})
+		}
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, shareWidth, shareImageHeight(len(lines))))
+	draw.Draw(img, img.Bounds(), image.NewUniform(shareBackground), image.Point{}, draw.Src)
+	drawer := &font.Drawer{Dst: img, Face: basicfont.Face7x13}
+	for i, line := range lines {
+		drawer.Src = image.NewUniform(line.color)
+		drawer.Dot = fixed.P(shareMargin, shareMargin+(i+1)*shareLineHeight-3)
+		drawer.DrawString(line.text)
+	}
+	return img
+}
+
+// writeResultsPNG escreve em w a imagem de resultados gerada por renderResultsImage
+func writeResultsPNG(w io.Writer, title, caption string, results []string) error {
+	return png.Encode(w, renderResultsImage(title, caption, results))
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// linesHaveColor indica se algum pixel da faixa de linhas de texto [from, to) tem a cor c
+func linesHaveColor(img *image.RGBA, c color.Color, from, to int) bool {
+	want := color.RGBAModel.Convert(c)
+	for y := shareMargin + from*shareLineHeight; y < shareMargin+to*shareLineHeight; y++ {
+		for x := 0; x < img.Bounds().Dx(); x++ {
+			if img.At(x, y) == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestRenderResultsImage(t *testing.T) {
+	results := []string{"1. Tit-for-Tat: 300", "2. Always Defect: 250", "3. Random: 200"}
+
+	// Sem legenda: título, linha em branco e os três resultados
+	img := renderResultsImage("Torneio", "", results)
+	if got, want := img.Bounds().Size(), image.Pt(shareWidth, shareImageHeight(5)); got != want {
+		t.Errorf("sem legenda: imagem %v, esperado %v", got, want)
+	}
+	if !linesHaveColor(img, shareTitle, 0, 1) || !linesHaveColor(img, shareText, 2, 5) {
+		t.Error("sem legenda: título ou resultados não desenhados")
+	}
+	if linesHaveColor(img, shareCaption, 0, 5) {
+		t.Error("sem legenda: a cor da legenda aparece na imagem")
+	}
+	if linesHaveColor(img, shareText, 1, 2) {
+		t.Error("sem legenda: a linha em branco depois do título tem texto")
+	}
+
+	// Uma legenda longa é quebrada em duas linhas, entre o título e os resultados
+	caption := strings.Repeat("palavra ", 15)
+	img = renderResultsImage("Torneio", caption, results)
+	if got, want := img.Bounds().Size(), image.Pt(shareWidth, shareImageHeight(7)); got != want {
+		t.Errorf("com legenda: imagem %v, esperado %v", got, want)
+	}
+	if !linesHaveColor(img, shareCaption, 1, 3) || linesHaveColor(img, shareCaption, 3, 7) {
+		t.Error("com legenda: a legenda não está nas linhas 1 e 2")
+	}
+
+	// O PNG tem as mesmas dimensões
+	var buf bytes.Buffer
+	if err := writeResultsPNG(&buf, "Torneio", "", results); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := decoded.Bounds().Size(), image.Pt(shareWidth, shareImageHeight(5)); got != want {
+		t.Errorf("PNG %v, esperado %v", got, want)
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+		want  []string
+	}{
+		{"", 10, nil},
+		{"um dois tres", 7, []string{"um dois", "tres"}},
+		{"a\n\nb", 10, []string{"a", "", "b"}},
+		{"abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		{"ok abcdefghij", 4, []string{"ok", "abcd", "efgh", "ij"}},
+	}
+	for _, tt := range tests {
+		if got := wrapText(tt.text, tt.width); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("wrapText(%q, %d) = %q, esperado %q", tt.text, tt.width, got, tt.want)
+		}
+	}
+}
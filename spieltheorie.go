@@ -1,8 +1,16 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
+	"hash/fnv"
+	"image/color"
+	"io"
+	"math"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -10,7 +18,9 @@ import (
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
+	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
 	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/widget"
 )
@@ -23,12 +33,166 @@ const (
 	Defect
 )
 
+// rng é o gerador aleatório usado pelas estratégias estocásticas
+var rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// seedRNG reinicia o gerador aleatório com a semente dada, tornando os jogos reproduzíveis
+func seedRNG(seed int64) {
+	rng = rand.New(rand.NewSource(seed))
+}
+
+// RandomAware é implementada por estratégias estocásticas que aceitam um gerador aleatório próprio,
+// para que os sorteios de uma estratégia não alterem os da outra
+type RandomAware interface {
+	SetRand(r *rand.Rand)
+}
+
+// setStrategyRand entrega um gerador aleatório próprio à estratégia, se ela sortear jogadas
+func setStrategyRand(s Strategy, r *rand.Rand) {
+	if a, ok := s.(RandomAware); ok {
+		a.SetRand(r)
+	}
+}
+
+// randomized é embutido pelas estratégias estocásticas: elas sorteiam com o gerador recebido do
+// jogo ou, sem ele, com o rng global. Clone não copia o gerador e Reset não o reinicia: cada jogo
+// entrega um gerador novo a cada instância (veja Game.SeedStrategies)
+type randomized struct {
+	source *rand.Rand
+}
+
+func (r *randomized) SetRand(source *rand.Rand) { r.source = source }
+
+// random retorna o gerador com que a estratégia deve sortear
+func (r randomized) random() *rand.Rand {
+	if r.source != nil {
+		return r.source
+	}
+	return rng
+}
+
+// strategySeed deriva a semente do gerador de uma estratégia da semente do jogo e da posição dela
+// no jogo (0 para A, 1 para B), sem repetir sementes entre jogos com sementes diferentes
+func strategySeed(matchSeed int64, position int) int64 {
+	return 2*matchSeed + int64(position)
+}
+
+// PayoffMatrix define os pontos de cada resultado possível de uma rodada
+type PayoffMatrix struct {
+	Reward     int // Ambos cooperam (R)
+	Sucker     int // Coopera e é traído (S)
+	Temptation int // Trai quem cooperou (T)
+	Punishment int // Ambos traem (P)
+}
+
+// defaultPayoff é a matriz padrão do jogo
+var defaultPayoff = PayoffMatrix{Reward: 7, Sucker: 0, Temptation: 10, Punishment: 1}
+
+// Points retorna os pontos de quem jogou own contra a jogada opponent
+func (m PayoffMatrix) Points(own, opponent Choice) int {
+	if own == Cooperate && opponent == Cooperate {
+		return m.Reward
+	} else if own == Cooperate && opponent == Defect {
+		return m.Sucker
+	} else if own == Defect && opponent == Cooperate {
+		return m.Temptation
+	}
+	return m.Punishment // Ambos traem
+}
+
+// formatPayoff escreve a matriz como "R, S, T, P", o formato lido por parsePayoff
+func formatPayoff(m PayoffMatrix) string {
+	return fmt.Sprintf("%d, %d, %d, %d", m.Reward, m.Sucker, m.Temptation, m.Punishment)
+}
+
+// parsePayoff lê uma matriz no formato "R, S, T, P", exigindo T > R > P > S para que o jogo
+// continue sendo um dilema do prisioneiro
+func parsePayoff(text string) (PayoffMatrix, error) {
+	fields := strings.Split(text, ",")
+	if len(fields) != 4 {
+		return PayoffMatrix{}, fmt.Errorf(tr("err_payoff"), text)
+	}
+	var values [4]int
+	for i, field := range fields {
+		value, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return PayoffMatrix{}, fmt.Errorf(tr("err_payoff"), text)
+		}
+		values[i] = value
+	}
+	m := PayoffMatrix{Reward: values[0], Sucker: values[1], Temptation: values[2], Punishment: values[3]}
+	if !(m.Temptation > m.Reward && m.Reward > m.Punishment && m.Punishment > m.Sucker) {
+		return PayoffMatrix{}, fmt.Errorf(tr("err_payoff_order"), text)
+	}
+	return m, nil
+}
+
 // Strategy define uma interface para as estratégias
 type Strategy interface {
 	NextMove(round int, opponentMoves []Choice) Choice
 	Name() string
 }
 
+// Resetter é implementada por estratégias com estado interno que precisa ser reiniciado a cada jogo
+type Resetter interface {
+	Reset()
+}
+
+// StatelessStrategy é implementada por estratégias que decidem só pela rodada, pelo histórico
+// recebido e pela configuração do jogo, sem guardar nada entre rodadas nem entre jogos
+type StatelessStrategy interface {
+	Stateless() bool
+}
+
+// isStateless informa se a estratégia declara não guardar estado; sem a declaração (como nos
+// plugins), supõe que guarda
+func isStateless(s Strategy) bool {
+	m, ok := s.(StatelessStrategy)
+	return ok && m.Stateless()
+}
+
+// Cloner é implementada por estratégias configuráveis que não podem ser recriadas só pelo nome
+type Cloner interface {
+	Clone() Strategy
+}
+
+// PayoffAware é implementada por estratégias que consultam a matriz de pontuação do jogo
+type PayoffAware interface {
+	SetPayoff(m PayoffMatrix)
+}
+
+// setStrategyPayoff informa a matriz de pontuação à estratégia, se ela a consultar
+func setStrategyPayoff(s Strategy, m PayoffMatrix) {
+	if p, ok := s.(PayoffAware); ok {
+		p.SetPayoff(m)
+	}
+}
+
+// HorizonAware é implementada por estratégias que usam o número total de rodadas do jogo
+type HorizonAware interface {
+	SetHorizon(rounds int)
+}
+
+// setStrategyHorizon informa o número total de rodadas à estratégia, se ela o consultar
+func setStrategyHorizon(s Strategy, rounds int) {
+	if h, ok := s.(HorizonAware); ok {
+		h.SetHorizon(rounds)
+	}
+}
+
+// Learner é implementada por estratégias que aprendem entre jogos: recebem a pontuação de cada
+// jogo terminado e, por isso, não devem ser recriadas entre os jogos de um torneio
+type Learner interface {
+	Learn(score, rounds int)
+}
+
+// resetStrategy reinicia o estado da estratégia, se ela tiver algum
+func resetStrategy(s Strategy) {
+	if r, ok := s.(Resetter); ok {
+		r.Reset()
+	}
+}
+
 // TitForTat: Coopera na primeira rodada, depois imita o último movimento do oponente
 type TitForTat struct{}
 
@@ -38,21 +202,47 @@ func (s TitForTat) NextMove(round int, opponentMoves []Choice) Choice {
 	}
 	return opponentMoves[len(opponentMoves)-1]
 }
-func (s TitForTat) Name() string { return "Tit-for-Tat" }
+func (s TitForTat) Name() string    { return "Tit-for-Tat" }
+func (s TitForTat) Stateless() bool { return true }
 
 // Random: Escolhe aleatoriamente entre cooperar e trair
-type Random struct{}
+type Random struct {
+	randomized
+}
 
 func (s Random) NextMove(round int, opponentMoves []Choice) Choice {
-	if rand.Intn(2) == 0 {
+	if s.random().Intn(2) == 0 {
 		return Cooperate
 	}
 	return Defect
 }
-func (s Random) Name() string { return "Random" }
+func (s Random) Name() string    { return "Random" }
+func (s Random) Stateless() bool { return true }
 
 // TidemanChieruzzi: Variação de Tit-for-Tat com perdão baseado no histórico
-type TidemanChieruzzi struct{}
+type TidemanChieruzzi struct {
+	window     int     // Quantas rodadas recentes são analisadas (padrão: 5)
+	threshold  float64 // Fração de traições recentes abaixo da qual perdoa (padrão: 0.5)
+	configured bool    // Criada por NewTidemanChieruzzi; o valor zero usa os padrões
+}
+
+// NewTidemanChieruzzi cria a estratégia com a janela e o limite de perdão dados
+func NewTidemanChieruzzi(window int, threshold float64) TidemanChieruzzi {
+	return TidemanChieruzzi{window: window, threshold: threshold, configured: true}
+}
+
+// params retorna a janela e o limite configurados, usando os padrões para valores não definidos.
+// Um limite configurado de 0 é mantido: a estratégia nunca perdoa
+func (s TidemanChieruzzi) params() (int, float64) {
+	window, threshold := s.window, s.threshold
+	if window <= 0 {
+		window = 5
+	}
+	if !s.configured {
+		threshold = 0.5
+	}
+	return window, threshold
+}
 
 func (s TidemanChieruzzi) NextMove(round int, opponentMoves []Choice) Choice {
 	if round == 0 || len(opponentMoves) == 0 {
@@ -61,22 +251,27 @@ func (s TidemanChieruzzi) NextMove(round int, opponentMoves []Choice) Choice {
 	// Se o oponente traiu na última rodada, verifica o histórico
 	lastMove := opponentMoves[len(opponentMoves)-1]
 	if lastMove == Defect {
-		// Conta o número de traições e cooperações recentes (últimas 5 rodadas)
+		window, threshold := s.params()
+		// Conta o número de traições e cooperações recentes (últimas rodadas da janela)
 		recentDefects := 0
-		recentMoves := opponentMoves[max(0, len(opponentMoves)-5):]
+		recentMoves := opponentMoves[max(0, len(opponentMoves)-window):]
 		for _, move := range recentMoves {
 			if move == Defect {
 				recentDefects++
 			}
 		}
-		// Perdoa se o oponente traiu menos de 50% das vezes recentemente
-		if recentDefects < len(recentMoves)/2 {
+		// Perdoa se o oponente traiu menos que o limite recentemente (arredondado para baixo)
+		if recentDefects < int(float64(len(recentMoves))*threshold) {
 			return Cooperate
 		}
 	}
 	return lastMove
 }
-func (s TidemanChieruzzi) Name() string { return "Tideman & Chieruzzi" }
+func (s TidemanChieruzzi) Name() string    { return "Tideman & Chieruzzi" }
+func (s TidemanChieruzzi) Stateless() bool { return true }
+
+// Clone preserva a janela e o limite configurados, que se perderiam ao recriar pelo nome
+func (s TidemanChieruzzi) Clone() Strategy { return s }
 
 // Nydegger: Usa uma sequência inicial para testar o oponente
 type Nydegger struct{}
@@ -94,7 +289,8 @@ func (s Nydegger) NextMove(round int, opponentMoves []Choice) Choice {
 	// Após as 3 primeiras rodadas, decide com base nas respostas do oponente
 	if round == 3 {
 		// Se o oponente cooperou nas 3 primeiras rodadas, coopera
-		if opponentMoves[0] == Cooperate && opponentMoves[1] == Cooperate && opponentMoves[2] == Cooperate {
+		// (com uma janela de histórico menor que 3, as jogadas que faltam não são vistas)
+		if len(opponentMoves) >= 3 && opponentMoves[0] == Cooperate && opponentMoves[1] == Cooperate && opponentMoves[2] == Cooperate {
 			return Cooperate
 		}
 		return Defect
@@ -102,7 +298,8 @@ func (s Nydegger) NextMove(round int, opponentMoves []Choice) Choice {
 	// Depois disso, age como Tit-for-Tat
 	return opponentMoves[len(opponentMoves)-1]
 }
-func (s Nydegger) Name() string { return "Nydegger" }
+func (s Nydegger) Name() string    { return "Nydegger" }
+func (s Nydegger) Stateless() bool { return true }
 
 // Grofman: Coopera na maioria das vezes, trai a cada 5 rodadas
 type Grofman struct{}
@@ -113,7 +310,8 @@ func (s Grofman) NextMove(round int, opponentMoves []Choice) Choice {
 	}
 	return Cooperate
 }
-func (s Grofman) Name() string { return "Grofman" }
+func (s Grofman) Name() string    { return "Grofman" }
+func (s Grofman) Stateless() bool { return true }
 
 // Shubik: Tit-for-Tat com punição prolongada (2 rodadas de traição)
 type Shubik struct {
@@ -137,9 +335,15 @@ func (s *Shubik) NextMove(round int, opponentMoves []Choice) Choice {
 	return Cooperate
 }
 func (s Shubik) Name() string { return "Shubik" }
+func (s *Shubik) Reset()      { s.defectCount = 0 }
+func (s *Shubik) State() string {
+	return fmt.Sprintf("defectCount=%d", s.defectCount)
+}
 
 // SteinRapoport: Tit-for-Tat com perdão aleatório
-type SteinRapoport struct{}
+type SteinRapoport struct {
+	randomized
+}
 
 func (s SteinRapoport) NextMove(round int, opponentMoves []Choice) Choice {
 	if round == 0 || len(opponentMoves) == 0 {
@@ -148,13 +352,14 @@ func (s SteinRapoport) NextMove(round int, opponentMoves []Choice) Choice {
 	lastMove := opponentMoves[len(opponentMoves)-1]
 	if lastMove == Defect {
 		// 20% de chance de perdoar uma traição
-		if rand.Float64() < 0.2 {
+		if s.random().Float64() < 0.2 {
 			return Cooperate
 		}
 	}
 	return lastMove
 }
-func (s SteinRapoport) Name() string { return "Stein & Rapoport" }
+func (s SteinRapoport) Name() string    { return "Stein & Rapoport" }
+func (s SteinRapoport) Stateless() bool { return true }
 
 // Friedman: Grim Trigger (trai para sempre após a primeira traição)
 type Friedman struct {
@@ -177,6 +382,10 @@ func (s *Friedman) NextMove(round int, opponentMoves []Choice) Choice {
 	return Cooperate
 }
 func (s Friedman) Name() string { return "Friedman" }
+func (s *Friedman) Reset()      { s.triggered = false }
+func (s *Friedman) State() string {
+	return fmt.Sprintf("triggered=%t", s.triggered)
+}
 
 // Davis: Coopera por 10 rodadas, depois age como Tit-for-Tat
 type Davis struct{}
@@ -187,7 +396,8 @@ func (s Davis) NextMove(round int, opponentMoves []Choice) Choice {
 	}
 	return opponentMoves[len(opponentMoves)-1]
 }
-func (s Davis) Name() string { return "Davis" }
+func (s Davis) Name() string    { return "Davis" }
+func (s Davis) Stateless() bool { return true }
 
 // Graaskamp: Analisa a proporção de traições do oponente
 type Graaskamp struct{}
@@ -210,7 +420,8 @@ func (s Graaskamp) NextMove(round int, opponentMoves []Choice) Choice {
 	}
 	return Cooperate
 }
-func (s Graaskamp) Name() string { return "Graaskamp" }
+func (s Graaskamp) Name() string    { return "Graaskamp" }
+func (s Graaskamp) Stateless() bool { return true }
 
 // Downing: Estima se o oponente responde melhor a cooperação ou traição
 type Downing struct {
@@ -237,9 +448,15 @@ func (s *Downing) NextMove(round int, opponentMoves []Choice) Choice {
 	return Defect
 }
 func (s Downing) Name() string { return "Downing" }
+func (s *Downing) Reset()      { s.coopScore, s.defectScore = 0, 0 }
+func (s *Downing) State() string {
+	return fmt.Sprintf("coopScore=%d defectScore=%d", s.coopScore, s.defectScore)
+}
 
 // Feld: Aumenta a probabilidade de trair ao longo do jogo
-type Feld struct{}
+type Feld struct {
+	randomized
+}
 
 func (s Feld) NextMove(round int, opponentMoves []Choice) Choice {
 	// Probabilidade de trair aumenta linearmente com o número de rodadas
@@ -247,335 +464,3328 @@ func (s Feld) NextMove(round int, opponentMoves []Choice) Choice {
 	if probDefect > 1.0 {
 		probDefect = 1.0
 	}
-	if rand.Float64() < probDefect {
+	if s.random().Float64() < probDefect {
 		return Defect
 	}
 	return Cooperate
 }
-func (s Feld) Name() string { return "Feld" }
+func (s Feld) Name() string    { return "Feld" }
+func (s Feld) Stateless() bool { return true }
 
 // Joss: Tit-for-Tat com 10% de chance de trair
-type Joss struct{}
+type Joss struct {
+	randomized
+}
 
 func (s Joss) NextMove(round int, opponentMoves []Choice) Choice {
 	if round == 0 || len(opponentMoves) == 0 {
 		return Cooperate
 	}
 	// 10% de chance de trair, independentemente do oponente
-	if rand.Float64() < 0.1 {
+	if s.random().Float64() < 0.1 {
 		return Defect
 	}
 	return opponentMoves[len(opponentMoves)-1]
 }
-func (s Joss) Name() string { return "Joss" }
+func (s Joss) Name() string    { return "Joss" }
+func (s Joss) Stateless() bool { return true }
 
 // Tullock: Coopera na maioria das vezes, trai ocasionalmente
-type Tullock struct{}
+type Tullock struct {
+	randomized
+}
 
 func (s Tullock) NextMove(round int, opponentMoves []Choice) Choice {
 	// 5% de chance de trair para testar o oponente
-	if rand.Float64() < 0.05 {
+	if s.random().Float64() < 0.05 {
 		return Defect
 	}
 	return Cooperate
 }
-func (s Tullock) Name() string { return "Tullock" }
+func (s Tullock) Name() string    { return "Tullock" }
+func (s Tullock) Stateless() bool { return true }
 
 // NameWithheld: Variação de Tit-for-Tat com 5% de chance de trair
-type NameWithheld struct{}
+type NameWithheld struct {
+	randomized
+}
 
 func (s NameWithheld) NextMove(round int, opponentMoves []Choice) Choice {
 	if round == 0 || len(opponentMoves) == 0 {
 		return Cooperate
 	}
 	// 5% de chance de trair
-	if rand.Float64() < 0.05 {
+	if s.random().Float64() < 0.05 {
 		return Defect
 	}
 	return opponentMoves[len(opponentMoves)-1]
 }
-func (s NameWithheld) Name() string { return "Name Withheld" }
+func (s NameWithheld) Name() string    { return "Name Withheld" }
+func (s NameWithheld) Stateless() bool { return true }
 
-// Game representa o estado do jogo
-type Game struct {
-	strategyA, strategyB Strategy
-	rounds               int
-	scores               [2]int
-	movesA, movesB       []Choice
+// Alternator: Coopera nas rodadas pares e trai nas ímpares, ignorando o oponente
+type Alternator struct{}
+
+func (s Alternator) NextMove(round int, opponentMoves []Choice) Choice {
+	if round%2 == 0 {
+		return Cooperate
+	}
+	return Defect
 }
+func (s Alternator) Name() string    { return "Alternator" }
+func (s Alternator) Stateless() bool { return true }
 
-// NewGame cria um novo jogo
-func NewGame(strategyA, strategyB Strategy, rounds int) *Game {
-	return &Game{
-		strategyA: strategyA,
-		strategyB: strategyB,
-		rounds:    rounds,
-		scores:    [2]int{0, 0},
-		movesA:    make([]Choice, 0, rounds),
-		movesB:    make([]Choice, 0, rounds),
+// AlwaysCooperate: Sempre coopera
+type AlwaysCooperate struct{}
+
+func (s AlwaysCooperate) NextMove(round int, opponentMoves []Choice) Choice { return Cooperate }
+func (s AlwaysCooperate) Name() string                                      { return "Always Cooperate" }
+func (s AlwaysCooperate) Stateless() bool                                   { return true }
+
+// AlwaysDefect: Sempre trai
+type AlwaysDefect struct{}
+
+func (s AlwaysDefect) NextMove(round int, opponentMoves []Choice) Choice { return Defect }
+func (s AlwaysDefect) Name() string                                      { return "Always Defect" }
+func (s AlwaysDefect) Stateless() bool                                   { return true }
+
+// Phased: Joga a primeira estratégia até a rodada de troca e a segunda daí em diante
+// (Davis, por exemplo, é Always Cooperate seguida de Tit-for-Tat a partir da rodada 10)
+type Phased struct {
+	first, second Strategy
+	switchRound   int
+}
+
+// NewPhased cria uma estratégia composta que troca de first para second na rodada switchRound
+func NewPhased(first, second Strategy, switchRound int) *Phased {
+	return &Phased{first: first, second: second, switchRound: switchRound}
+}
+
+func (s *Phased) NextMove(round int, opponentMoves []Choice) Choice {
+	// A sub-estratégia recebe a rodada e o histórico completos, não só os da sua fase
+	if round < s.switchRound {
+		return s.first.NextMove(round, opponentMoves)
 	}
+	return s.second.NextMove(round, opponentMoves)
+}
+func (s *Phased) Name() string {
+	return fmt.Sprintf(tr("phased_name"), s.first.Name(), s.second.Name(), s.switchRound)
+}
+func (s *Phased) Reset() {
+	resetStrategy(s.first)
+	resetStrategy(s.second)
+}
+func (s *Phased) SetPayoff(m PayoffMatrix) {
+	setStrategyPayoff(s.first, m)
+	setStrategyPayoff(s.second, m)
+}
+func (s *Phased) SetHorizon(rounds int) {
+	setStrategyHorizon(s.first, rounds)
+	setStrategyHorizon(s.second, rounds)
+}
+func (s *Phased) SetRand(r *rand.Rand) {
+	setStrategyRand(s.first, r)
+	setStrategyRand(s.second, r)
+}
+func (s *Phased) Clone() Strategy {
+	return NewPhased(freshInstance(s.first), freshInstance(s.second), s.switchRound)
 }
 
-// PlayRound joga uma rodada e atualiza os pontos
-func (g *Game) PlayRound(round int) {
-	moveA := g.strategyA.NextMove(round, g.movesB)
-	moveB := g.strategyB.NextMove(round, g.movesA)
+// MixedStrategy: A cada rodada, consulta a primeira estratégia com probabilidade p e a segunda
+// nas demais (por exemplo, 80% Tit-for-Tat e 20% Always Defect). A consultada recebe a rodada e o
+// histórico completos; a outra não é consultada naquela rodada
+type MixedStrategy struct {
+	randomized
+	first, second Strategy
+	p             float64
+}
 
-	g.movesA = append(g.movesA, moveA)
-	g.movesB = append(g.movesB, moveB)
+// NewMixedStrategy cria uma estratégia que delega a first com probabilidade p e a second nas demais rodadas
+func NewMixedStrategy(first, second Strategy, p float64) *MixedStrategy {
+	return &MixedStrategy{first: first, second: second, p: p}
+}
 
-	// Calcula pontuação
-	if moveA == Cooperate && moveB == Cooperate {
-		g.scores[0] += 7
-		g.scores[1] += 7
-	} else if moveA == Cooperate && moveB == Defect {
-		g.scores[0] += 0
-		g.scores[1] += 10
-	} else if moveA == Defect && moveB == Cooperate {
-		g.scores[0] += 10
-		g.scores[1] += 0
-	} else { // Ambos traem
-		g.scores[0] += 1
-		g.scores[1] += 1
-	}
-}
-
-// moveToSymbol converte a escolha em um símbolo visual
-func moveToSymbol(move Choice) string {
-	if move == Cooperate {
-		return "✅"
+func (s *MixedStrategy) NextMove(round int, opponentMoves []Choice) Choice {
+	if s.random().Float64() < s.p {
+		return s.first.NextMove(round, opponentMoves)
 	}
-	return "❌"
+	return s.second.NextMove(round, opponentMoves)
+}
+func (s *MixedStrategy) Name() string {
+	return fmt.Sprintf(tr("mixed_name"), s.p*100, s.first.Name(), (1-s.p)*100, s.second.Name())
+}
+func (s *MixedStrategy) Reset() {
+	resetStrategy(s.first)
+	resetStrategy(s.second)
+}
+func (s *MixedStrategy) SetPayoff(m PayoffMatrix) {
+	setStrategyPayoff(s.first, m)
+	setStrategyPayoff(s.second, m)
+}
+func (s *MixedStrategy) SetHorizon(rounds int) {
+	setStrategyHorizon(s.first, rounds)
+	setStrategyHorizon(s.second, rounds)
+}
+func (s *MixedStrategy) SetRand(r *rand.Rand) {
+	s.source = r
+	setStrategyRand(s.first, r)
+	setStrategyRand(s.second, r)
+}
+func (s *MixedStrategy) Clone() Strategy {
+	return NewMixedStrategy(freshInstance(s.first), freshInstance(s.second), s.p)
 }
 
-// max é uma função auxiliar para evitar índices negativos
-func max(a, b int) int {
-	if a > b {
-		return a
+// FrequencyModeler: Aprende como o oponente costuma responder a cada uma das suas jogadas
+// e escolhe a jogada que, pelo histórico, mais provoca cooperação
+type FrequencyModeler struct {
+	ownMoves  []Choice
+	responses [2][2]int // responses[minha jogada][resposta do oponente na rodada seguinte]
+}
+
+func (s *FrequencyModeler) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		s.ownMoves = append(s.ownMoves, Cooperate)
+		return Cooperate
 	}
-	return b
+	// A última jogada do oponente é a resposta à nossa jogada da rodada anterior a ela
+	if n := len(s.ownMoves); n >= 2 {
+		s.responses[s.ownMoves[n-2]][opponentMoves[len(opponentMoves)-1]]++
+	}
+	// Probabilidade estimada de cooperação do oponente após cada jogada (com suavização de Laplace)
+	coopAfter := func(move Choice) float64 {
+		counts := s.responses[move]
+		return float64(counts[Cooperate]+1) / float64(counts[Cooperate]+counts[Defect]+2)
+	}
+	move := Cooperate
+	if coopAfter(Defect) > coopAfter(Cooperate) {
+		move = Defect
+	}
+	s.ownMoves = append(s.ownMoves, move)
+	return move
+}
+func (s FrequencyModeler) Name() string { return "Frequency Modeler" }
+func (s *FrequencyModeler) Reset() {
+	s.ownMoves = s.ownMoves[:0]
+	s.responses = [2][2]int{}
 }
 
-// Result representa o resultado de uma estratégia no modo "todos contra todos"
-type Result struct {
-	name  string
-	score int
-}
-
-// runAllAgainstAll executa o modo "todos contra todos" e retorna os resultados
-func runAllAgainstAll(strategies []Strategy, rounds int) []Result {
-	// Mapa para armazenar a pontuação total de cada estratégia
-	totalScores := make(map[string]int)
-
-	// Cada estratégia enfrenta todas as outras (incluindo a si mesma)
-	for _, stratA := range strategies {
-		for _, stratB := range strategies {
-			// Cria instâncias frescas das estratégias para evitar estado compartilhado
-			var strategyA, strategyB Strategy
-			switch stratA.Name() {
-			case "Shubik":
-				strategyA = &Shubik{}
-			case "Friedman":
-				strategyA = &Friedman{}
-			case "Downing":
-				strategyA = &Downing{}
-			default:
-				strategyA = stratA
-			}
-			switch stratB.Name() {
-			case "Shubik":
-				strategyB = &Shubik{}
-			case "Friedman":
-				strategyB = &Friedman{}
-			case "Downing":
-				strategyB = &Downing{}
-			default:
-				strategyB = stratB
-			}
+// ForgivingPavlov: Pavlov (repete a jogada que pontuou bem, troca a que pontuou mal) que só passa
+// a trair quando sua cooperação foi explorada e perdoa a traição mútua tentando cooperar de novo
+type ForgivingPavlov struct {
+	payoff   PayoffMatrix
+	lastMove Choice
+}
 
-			// Executa o jogo entre strategyA e strategyB
-			game := NewGame(strategyA, strategyB, rounds)
-			for round := 0; round < rounds; round++ {
-				game.PlayRound(round)
-			}
+func (s *ForgivingPavlov) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.lastMove = Cooperate
+		return Cooperate
+	}
+	opponentLast := opponentMoves[len(opponentMoves)-1]
+	move := s.lastMove
+	switch {
+	case s.payoff.Points(s.lastMove, opponentLast) >= s.payoff.Reward:
+		// Pontuou bem (cooperação mútua ou exploração): mantém a jogada
+	case s.lastMove == Defect && opponentLast == Defect:
+		// Traição mútua: perdoa e tenta cooperar, ao contrário do Pavlov estrito
+		move = Cooperate
+	default:
+		// Cooperou e foi explorado: passa a trair
+		move = Defect
+	}
+	s.lastMove = move
+	return move
+}
+func (s ForgivingPavlov) Name() string              { return "Forgiving Pavlov" }
+func (s *ForgivingPavlov) Reset()                   { s.lastMove = Cooperate }
+func (s *ForgivingPavlov) SetPayoff(m PayoffMatrix) { s.payoff = m }
+
+// LaggedTitForTat: Tit-for-Tat com atraso de duas rodadas, imitando a jogada do oponente de
+// duas rodadas atrás (como se a comunicação chegasse atrasada); coopera nas duas primeiras rodadas
+type LaggedTitForTat struct{}
+
+func (s LaggedTitForTat) NextMove(round int, opponentMoves []Choice) Choice {
+	if round < 2 || len(opponentMoves) < 2 {
+		return Cooperate
+	}
+	return opponentMoves[len(opponentMoves)-2]
+}
+func (s LaggedTitForTat) Name() string    { return "Lagged Tit-for-Tat" }
+func (s LaggedTitForTat) Stateless() bool { return true }
+
+// ProportionalRetaliator: Tit-for-Tat que, após uma traição do oponente, retalia com probabilidade
+// igual à taxa de traição do oponente até agora (perdoa mais quem trai pouco)
+type ProportionalRetaliator struct {
+	randomized
+	seen, defects int // Jogadas do oponente já contabilizadas e quantas foram traições
+}
 
-			// Adiciona os pontos ao total de cada estratégia
-			totalScores[stratA.Name()] += game.scores[0]
-			totalScores[stratB.Name()] += game.scores[1]
+func (s *ProportionalRetaliator) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return Cooperate
+	}
+	// Atualiza a taxa de traição de forma incremental, só com as jogadas novas; com a janela de
+	// histórico (menos jogadas que rodadas), recomeça a contagem só com as jogadas visíveis
+	if len(opponentMoves) < round {
+		s.Reset()
+	}
+	for _, move := range opponentMoves[s.seen:] {
+		if move == Defect {
+			s.defects++
 		}
 	}
+	s.seen = len(opponentMoves)
 
-	// Converte os resultados para uma lista de Result
-	results := make([]Result, 0, len(strategies))
-	for name, score := range totalScores {
-		results = append(results, Result{name: name, score: score})
+	if opponentMoves[len(opponentMoves)-1] == Defect {
+		defectRate := float64(s.defects) / float64(s.seen)
+		if s.random().Float64() < defectRate {
+			return Defect
+		}
 	}
+	return Cooperate
+}
+func (s ProportionalRetaliator) Name() string { return "Proportional Retaliator" }
+func (s *ProportionalRetaliator) Reset()      { s.seen, s.defects = 0, 0 }
 
-	// Ordena os resultados por pontuação (maior para menor)
-	sort.Slice(results, func(i, j int) bool {
-		return results[i].score > results[j].score
-	})
+// Reflective: Coopera com probabilidade igual à frequência de cooperação do oponente até agora,
+// convergindo para a mesma generosidade que ele demonstra
+type Reflective struct {
+	randomized
+	seen, cooperations int // Jogadas do oponente já contabilizadas e quantas foram cooperações
+}
+
+func (s *Reflective) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return Cooperate
+	}
+	// Atualiza a frequência de forma incremental, só com as jogadas novas; com a janela de
+	// histórico (menos jogadas que rodadas), recomeça a contagem só com as jogadas visíveis
+	if len(opponentMoves) < round {
+		s.Reset()
+	}
+	for _, move := range opponentMoves[s.seen:] {
+		if move == Cooperate {
+			s.cooperations++
+		}
+	}
+	s.seen = len(opponentMoves)
 
-	return results
+	if s.random().Float64() < float64(s.cooperations)/float64(s.seen) {
+		return Cooperate
+	}
+	return Defect
 }
+func (s Reflective) Name() string { return "Reflective" }
+func (s *Reflective) Reset()      { s.seen, s.cooperations = 0, 0 }
 
-func main() {
-	// Seed para escolhas aleatórias
-	rand.Seed(time.Now().UnixNano())
+// ownHistory é embutido por estratégias que precisam lembrar das próprias jogadas, já que
+// NextMove só recebe as jogadas do oponente
+type ownHistory struct {
+	ownMoves []Choice
+}
 
-	// Cria a aplicação Fyne
-	myApp := app.New()
-	myWindow := myApp.NewWindow("Spieltheorie - Teoria dos Jogos")
-	myWindow.Resize(fyne.NewSize(800, 600))
+// play registra a própria jogada e a retorna
+func (h *ownHistory) play(move Choice) Choice {
+	h.ownMoves = append(h.ownMoves, move)
+	return move
+}
 
-	// Lista de estratégias disponíveis
-	strategies := []Strategy{
-		TitForTat{},
-		Random{},
-		TidemanChieruzzi{},
-		Nydegger{},
-		Grofman{},
-		&Shubik{},
-		SteinRapoport{},
-		&Friedman{},
-		Davis{},
-		Graaskamp{},
-		&Downing{},
-		Feld{},
-		Joss{},
-		Tullock{},
-		NameWithheld{},
+// runningScores calcula as pontuações acumuladas dos dois jogadores a partir dos históricos,
+// alinhados pelo fim (com a janela de histórico, o do oponente só tem as últimas rodadas)
+func runningScores(ownMoves, opponentMoves []Choice, m PayoffMatrix) (own, opponent int) {
+	for k := 1; k <= len(ownMoves) && k <= len(opponentMoves); k++ {
+		ownMove, opponentMove := ownMoves[len(ownMoves)-k], opponentMoves[len(opponentMoves)-k]
+		own += m.Points(ownMove, opponentMove)
+		opponent += m.Points(opponentMove, ownMove)
 	}
+	return own, opponent
+}
 
-	// Lista de nomes das estratégias para os dropdowns
-	strategyNames := make([]string, len(strategies))
-	for i, s := range strategies {
-		strategyNames[i] = s.Name()
+// FairnessEnforcer: Tenta manter o placar empatado, traindo quando está atrás e cooperando
+// quando está empatado ou à frente
+type FairnessEnforcer struct {
+	ownHistory
+	payoff PayoffMatrix
+}
+
+func (s *FairnessEnforcer) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return s.play(Cooperate)
 	}
+	own, opponent := runningScores(s.ownMoves, opponentMoves, s.payoff)
+	if own < opponent {
+		return s.play(Defect)
+	}
+	return s.play(Cooperate)
+}
+func (s FairnessEnforcer) Name() string              { return "Fairness Enforcer" }
+func (s *FairnessEnforcer) Reset()                   { s.ownMoves = s.ownMoves[:0] }
+func (s *FairnessEnforcer) SetPayoff(m PayoffMatrix) { s.payoff = m }
 
-	// Tela inicial: escolha entre modo normal e "todos contra todos"
-	welcomeLabel := widget.NewLabel("Bem-vindo ao Spieltheorie!")
-	welcomeLabel.Alignment = fyne.TextAlignCenter
+// CatchUp: Coopera quando está empatado ou à frente e, quando está atrás, trai com probabilidade
+// crescente com o tamanho da desvantagem no placar
+type CatchUp struct {
+	randomized
+	ownHistory
+	payoff    PayoffMatrix
+	steepness float64 // Inclinação da curva logística: quanto maior, mais cedo a traição fica provável
+}
 
-	normalModeButton := widget.NewButton("Modo Normal", func() {
-		// Tela do modo normal
-		strategyASelect := widget.NewSelect(strategyNames, func(value string) {})
-		strategyASelect.SetSelected(strategyNames[0])
-		strategyBSelect := widget.NewSelect(strategyNames, func(value string) {})
-		strategyBSelect.SetSelected(strategyNames[1])
+// NewCatchUp cria a estratégia com a inclinação dada, usando a matriz padrão até o jogo informar outra
+func NewCatchUp(steepness float64) *CatchUp {
+	return &CatchUp{payoff: defaultPayoff, steepness: steepness}
+}
 
-		roundsEntry := widget.NewEntry()
-		roundsEntry.SetPlaceHolder("Digite o número de rodadas")
+// catchUpDefectProbability converte a desvantagem no placar em probabilidade de trair: a
+// desvantagem é normalizada pela maior possível nas rodadas jogadas (ser explorado em todas) e
+// passa por uma logística ajustada para valer 0 sem desvantagem e se aproximar de 1 com a máxima
+func catchUpDefectProbability(deficit, rounds int, m PayoffMatrix, steepness float64) float64 {
+	maxDeficit := rounds * (m.Temptation - m.Sucker)
+	if deficit <= 0 || maxDeficit <= 0 {
+		return 0
+	}
+	normalized := float64(deficit) / float64(maxDeficit)
+	return 2/(1+math.Exp(-steepness*normalized)) - 1
+}
 
-		// Barra de progresso para o progresso das rodadas
-		progressBar := widget.NewProgressBar()
-		progressBar.Min = 0
-		progressBar.Max = 1
+func (s *CatchUp) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return s.play(Cooperate)
+	}
+	own, opponent := runningScores(s.ownMoves, opponentMoves, s.payoff)
+	if s.random().Float64() < catchUpDefectProbability(opponent-own, len(opponentMoves), s.payoff, s.steepness) {
+		return s.play(Defect)
+	}
+	return s.play(Cooperate)
+}
+func (s *CatchUp) Name() string             { return "Catch Up" }
+func (s *CatchUp) Reset()                   { s.ownMoves = s.ownMoves[:0] }
+func (s *CatchUp) SetPayoff(m PayoffMatrix) { s.payoff = m }
+func (s *CatchUp) Clone() Strategy          { return NewCatchUp(s.steepness) }
 
-		// Tabela para exibir o histórico das rodadas
-		type roundData struct {
-			round  int
-			moveA  string
-			moveB  string
-			scoreA int
-			scoreB int
-		}
-		roundsHistory := make([]roundData, 0)
+// Endgamer: Joga Tit-for-Tat, mas, quando conhece o total de rodadas, trai com probabilidade
+// crescente nas últimas finalRounds rodadas, chegando a maxDefect na última
+type Endgamer struct {
+	randomized
+	finalRounds int
+	maxDefect   float64
+	horizon     int // Total de rodadas do jogo; 0 se desconhecido
+}
 
-		// Cria a tabela
-		table := widget.NewTable(
-			func() (int, int) {
-				return len(roundsHistory), 5 // 5 colunas: Rodada, Move A, Move B, Score A, Score B
-			},
-			func() fyne.CanvasObject {
-				return widget.NewLabel("")
-			},
-			func(cell widget.TableCellID, o fyne.CanvasObject) {
-				label := o.(*widget.Label)
-				data := roundsHistory[cell.Row]
-				switch cell.Col {
-				case 0:
-					label.SetText(fmt.Sprintf("%d", data.round))
-				case 1:
-					label.SetText(data.moveA)
-				case 2:
-					label.SetText(data.moveB)
-				case 3:
-					label.SetText(fmt.Sprintf("%d", data.scoreA))
-				case 4:
-					label.SetText(fmt.Sprintf("%d", data.scoreB))
-				}
-			},
-		)
-		// Define os cabeçalhos da tabela
-		table.CreateHeader = func() fyne.CanvasObject {
-			return widget.NewLabel("")
-		}
-		table.UpdateHeader = func(cell widget.TableCellID, o fyne.CanvasObject) {
-			label := o.(*widget.Label)
-			switch cell.Col {
-			case 0:
-				label.SetText("Rodada")
-			case 1:
-				label.SetText("Jogada A")
-			case 2:
-				label.SetText("Jogada B")
-			case 3:
-				label.SetText("Pontuação A")
-			case 4:
-				label.SetText("Pontuação B")
-			}
+// NewEndgamer cria um Endgamer que começa a trair nas últimas finalRounds rodadas
+func NewEndgamer(finalRounds int, maxDefect float64) *Endgamer {
+	return &Endgamer{finalRounds: finalRounds, maxDefect: maxDefect}
+}
+
+func (s *Endgamer) NextMove(round int, opponentMoves []Choice) Choice {
+	if remaining := s.horizon - round; s.horizon > 0 && s.finalRounds > 0 && remaining <= s.finalRounds {
+		// Na última rodada (remaining == 1) a probabilidade chega a maxDefect
+		progress := float64(s.finalRounds-remaining+1) / float64(s.finalRounds)
+		if s.random().Float64() < s.maxDefect*progress {
+			return Defect
 		}
-		// Define larguras das colunas
-		table.SetColumnWidth(0, 80)
-		table.SetColumnWidth(1, 100)
-		table.SetColumnWidth(2, 100)
-		table.SetColumnWidth(3, 100)
-		table.SetColumnWidth(4, 100)
+	}
+	return TitForTat{}.NextMove(round, opponentMoves)
+}
+func (s *Endgamer) Name() string          { return "Endgamer" }
+func (s *Endgamer) Stateless() bool       { return true }
+func (s *Endgamer) SetHorizon(rounds int) { s.horizon = rounds }
+func (s *Endgamer) Clone() Strategy       { return NewEndgamer(s.finalRounds, s.maxDefect) }
 
-		// Define um tamanho mínimo para a tabela (ex.: 10 linhas visíveis)
-		table.MinSize()
-		tableContainer := container.NewVScroll(table)
-		tableContainer.SetMinSize(fyne.NewSize(500, 300)) // Ajusta para mostrar ~10 linhas
+// PeaceOffering: Joga Tit-for-Tat, mas, após k rodadas seguidas de traição mútua, coopera uma
+// vez para testar se o oponente retribui e quebrar o ciclo
+type PeaceOffering struct {
+	ownHistory
+	k int
+}
 
-		// Label para o resultado final
-		resultLabel := widget.NewLabel("")
-		resultLabel.Wrapping = fyne.TextWrapWord
+// NewPeaceOffering cria um PeaceOffering que oferece paz a cada k rodadas de traição mútua
+func NewPeaceOffering(k int) *PeaceOffering {
+	return &PeaceOffering{k: k}
+}
 
-		startButton := widget.NewButton("Iniciar Jogo", func() {
+func (s *PeaceOffering) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return s.play(Cooperate)
+	}
+	// Sequência atual de traições mútuas, contada a partir da última rodada
+	streak := 0
+	for own, opp := len(s.ownMoves)-1, len(opponentMoves)-1; own >= 0 && opp >= 0; own, opp = own-1, opp-1 {
+		if s.ownMoves[own] != Defect || opponentMoves[opp] != Defect {
+			break
+		}
+		streak++
+	}
+	if s.k > 0 && streak >= s.k {
+		return s.play(Cooperate)
+	}
+	return s.play(opponentMoves[len(opponentMoves)-1])
+}
+func (s *PeaceOffering) Name() string    { return "Peace Offering" }
+func (s *PeaceOffering) Reset()          { s.ownMoves = s.ownMoves[:0] }
+func (s *PeaceOffering) Clone() Strategy { return NewPeaceOffering(s.k) }
+
+// WeightedMajority: Coopera se a maioria das jogadas do oponente foi cooperação, dando mais peso
+// às recentes: cada jogada vale decay vezes o peso da seguinte (decay = 1 é a maioria simples)
+type WeightedMajority struct {
+	decay float64
+	seen  int     // Jogadas do oponente já contabilizadas
+	score float64 // Soma ponderada: +1 por cooperação, -1 por traição
+}
+
+// NewWeightedMajority cria a estratégia com o fator de decaimento dado, entre 0 e 1
+func NewWeightedMajority(decay float64) *WeightedMajority {
+	return &WeightedMajority{decay: decay}
+}
+
+func (s *WeightedMajority) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return Cooperate
+	}
+	// Atualiza a soma de forma incremental: o peso das jogadas antigas decai a cada jogada nova.
+	// Com a janela de histórico (menos jogadas que rodadas), recalcula só com as jogadas visíveis
+	if len(opponentMoves) < round {
+		s.Reset()
+	}
+	for _, move := range opponentMoves[s.seen:] {
+		s.score *= s.decay
+		if move == Cooperate {
+			s.score++
+		} else {
+			s.score--
+		}
+	}
+	s.seen = len(opponentMoves)
+
+	if s.score >= 0 {
+		return Cooperate
+	}
+	return Defect
+}
+func (s *WeightedMajority) Name() string    { return "Weighted Majority" }
+func (s *WeightedMajority) Reset()          { s.seen, s.score = 0, 0 }
+func (s *WeightedMajority) Clone() Strategy { return NewWeightedMajority(s.decay) }
+
+// Estados do Negotiator
+const (
+	negotiatorOffering  = iota // Oferecendo cooperação, aguardando a resposta do oponente
+	negotiatorWarning          // Acabou de trair como aviso
+	negotiatorCommitted        // O oponente retribuiu: comprometido com a cooperação
+	negotiatorHostile          // O oponente ignorou dois avisos: trai para sempre
+)
+
+// Negotiator: Trata as jogadas como sinais: oferece cooperação e se compromete com ela se o
+// oponente retribuir; a cada traição, dá uma traição de aviso e volta a oferecer cooperação,
+// e só escala para traição permanente quando o oponente ignora a oferta duas vezes
+type Negotiator struct {
+	state   int
+	ignored int // Traições do oponente que receberam aviso
+}
+
+func (s *Negotiator) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return Cooperate
+	}
+	switch s.state {
+	case negotiatorHostile:
+		return Defect
+	case negotiatorWarning:
+		// Depois do aviso, oferece cooperação de novo, qualquer que seja a resposta ao aviso
+		s.state = negotiatorOffering
+		return Cooperate
+	}
+	if opponentMoves[len(opponentMoves)-1] == Cooperate {
+		s.state = negotiatorCommitted
+		return Cooperate
+	}
+	s.ignored++
+	if s.ignored >= 2 {
+		s.state = negotiatorHostile
+		return Defect
+	}
+	s.state = negotiatorWarning
+	return Defect
+}
+func (s *Negotiator) Name() string { return "Negotiator" }
+func (s *Negotiator) Reset()       { s.state, s.ignored = negotiatorOffering, 0 }
+func (s *Negotiator) State() string {
+	return fmt.Sprintf("state=%d ignored=%d", s.state, s.ignored)
+}
+
+// MetaLearner: Tit-for-Tat que perdoa traições com probabilidade forgiveness e, entre um jogo e
+// outro, ajusta essa probabilidade por subida de encosta: mantém a direção do último ajuste se a
+// pontuação média por rodada melhorou e a inverte se piorou
+type MetaLearner struct {
+	randomized
+	initial, step float64
+	forgiveness   float64
+	direction     float64 // +1 ou -1: sentido do próximo ajuste
+	lastAverage   float64 // Pontuação média por rodada no jogo anterior
+	played        bool    // Se já terminou algum jogo
+}
+
+// NewMetaLearner cria a estratégia com a probabilidade de perdão inicial e o tamanho do ajuste dados
+func NewMetaLearner(initial, step float64) *MetaLearner {
+	return &MetaLearner{initial: initial, step: step, forgiveness: initial, direction: 1}
+}
+
+func (s *MetaLearner) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		return Cooperate
+	}
+	lastMove := opponentMoves[len(opponentMoves)-1]
+	if lastMove == Defect && s.random().Float64() < s.forgiveness {
+		return Cooperate
+	}
+	return lastMove
+}
+func (s *MetaLearner) Name() string    { return "Meta Learner" }
+func (s *MetaLearner) Clone() Strategy { return NewMetaLearner(s.initial, s.step) }
+func (s *MetaLearner) Learn(score, rounds int) {
+	if rounds <= 0 {
+		return
+	}
+	average := float64(score) / float64(rounds)
+	if s.played && average < s.lastAverage {
+		s.direction = -s.direction
+	}
+	s.forgiveness = math.Min(1, math.Max(0, s.forgiveness+s.direction*s.step))
+	s.lastAverage, s.played = average, true
+}
+func (s *MetaLearner) State() string {
+	return fmt.Sprintf("forgiveness=%.2f", s.forgiveness)
+}
+
+// qLearnerDiscount é o fator de desconto das recompensas futuras do QLearner. Sem desconto
+// (0) ele só veria a pontuação imediata e nunca aprenderia que cooperar com Tit-for-Tat compensa
+const qLearnerDiscount = 0.9
+
+// QLearner: Aprende por reforço (Q-learning tabular) qual jogada rende mais em cada estado, sendo o
+// estado as últimas memory jogadas do oponente. A cada rodada atualiza a tabela com a pontuação real
+// da matriz do jogo e escolhe a melhor jogada conhecida, explorando ao acaso com probabilidade epsilon
+type QLearner struct {
+	randomized
+	memory       int     // Jogadas do oponente que formam o estado (1 ou 2)
+	learningRate float64 // Taxa de aprendizado (alfa)
+	epsilon      float64 // Probabilidade de explorar uma jogada aleatória
+	payoff       PayoffMatrix
+	q            [][2]float64 // Valor estimado de cada jogada (Cooperate, Defect) em cada estado
+	lastState    int
+	lastMove     Choice
+}
+
+// NewQLearner cria a estratégia com o tamanho de memória, a taxa de aprendizado e a taxa de
+// exploração dados, usando a matriz padrão até o jogo informar outra
+func NewQLearner(memory int, learningRate, epsilon float64) *QLearner {
+	memory = min(max(memory, 1), 2)
+	s := &QLearner{memory: memory, learningRate: learningRate, epsilon: epsilon, payoff: defaultPayoff}
+	s.Reset()
+	return s
+}
+
+// state codifica as últimas memory jogadas do oponente como um índice da tabela; rodadas
+// anteriores ao início do jogo contam como cooperação
+func (s *QLearner) state(opponentMoves []Choice) int {
+	state := 0
+	for k := 1; k <= s.memory; k++ {
+		state <<= 1
+		if i := len(opponentMoves) - k; i >= 0 && opponentMoves[i] == Defect {
+			state |= 1
+		}
+	}
+	return state
+}
+
+// bestMove retorna a jogada de maior valor no estado, preferindo cooperar em caso de empate
+func (s *QLearner) bestMove(state int) Choice {
+	if s.q[state][Defect] > s.q[state][Cooperate] {
+		return Defect
+	}
+	return Cooperate
+}
+
+func (s *QLearner) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+	} else {
+		// Atualiza o valor da jogada anterior com a pontuação obtida e o melhor valor do novo estado
+		next := s.state(opponentMoves)
+		reward := float64(s.payoff.Points(s.lastMove, opponentMoves[len(opponentMoves)-1]))
+		target := reward + qLearnerDiscount*s.q[next][s.bestMove(next)]
+		s.q[s.lastState][s.lastMove] += s.learningRate * (target - s.q[s.lastState][s.lastMove])
+	}
+
+	s.lastState = s.state(opponentMoves)
+	s.lastMove = s.bestMove(s.lastState)
+	if s.random().Float64() < s.epsilon {
+		s.lastMove = Choice(s.random().Intn(2))
+	}
+	return s.lastMove
+}
+func (s *QLearner) Name() string { return "Q-Learner" }
+func (s *QLearner) Reset() {
+	// Começa otimista (a maior pontuação descontada possível), para experimentar as duas jogadas
+	// em cada estado antes de se fixar numa delas
+	optimistic := float64(s.payoff.Temptation) / (1 - qLearnerDiscount)
+	s.q = make([][2]float64, 1<<s.memory)
+	for i := range s.q {
+		s.q[i] = [2]float64{optimistic, optimistic}
+	}
+	s.lastState, s.lastMove = 0, Cooperate
+}
+func (s *QLearner) SetPayoff(m PayoffMatrix) { s.payoff = m }
+func (s *QLearner) Clone() Strategy          { return NewQLearner(s.memory, s.learningRate, s.epsilon) }
+func (s *QLearner) State() string {
+	return fmt.Sprintf("q=%.1f", s.q)
+}
+
+// StimulusResponse: Estima a probabilidade de o oponente cooperar depois de ela cooperar e depois
+// de ela trair, e joga a ação que renderia mais se repetida, dadas essas estimativas e a matriz.
+// Ao contrário de Downing, condiciona as respostas do oponente à própria jogada anterior
+type StimulusResponse struct {
+	ownHistory
+	payoff       PayoffMatrix
+	responses    [2]int // Respostas do oponente observadas após cada jogada própria (Cooperate, Defect)
+	cooperations [2]int // Quantas dessas respostas foram cooperação
+	seen         int    // Jogadas do oponente já contabilizadas
+}
+
+// cooperationAfter estima P(oponente coopera | jogou move na rodada anterior), com a correção de
+// Laplace para começar em 0,5 sem observações
+func (s *StimulusResponse) cooperationAfter(move Choice) float64 {
+	return float64(s.cooperations[move]+1) / float64(s.responses[move]+2)
+}
+
+// expectedPayoff é a pontuação esperada por rodada se jogar sempre move e o oponente responder
+// conforme as estimativas
+func (s *StimulusResponse) expectedPayoff(move Choice) float64 {
+	p := s.cooperationAfter(move)
+	return p*float64(s.payoff.Points(move, Cooperate)) + (1-p)*float64(s.payoff.Points(move, Defect))
+}
+
+func (s *StimulusResponse) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return s.play(Cooperate)
+	}
+	// Alinha os históricos pelo fim: com a janela de histórico, o do oponente só tem as últimas
+	// rodadas, e as estimativas passam a considerar só elas
+	offset := len(s.ownMoves) - len(opponentMoves)
+	if offset > 0 {
+		s.responses, s.cooperations, s.seen = [2]int{}, [2]int{}, 0
+	}
+	// A jogada i do oponente é a resposta à própria jogada i-1 (índices do próprio histórico)
+	for i := max(max(s.seen, offset+1), 1); i < len(s.ownMoves); i++ {
+		stimulus := s.ownMoves[i-1]
+		s.responses[stimulus]++
+		if opponentMoves[i-offset] == Cooperate {
+			s.cooperations[stimulus]++
+		}
+	}
+	s.seen = len(s.ownMoves)
+
+	if s.expectedPayoff(Defect) > s.expectedPayoff(Cooperate) {
+		return s.play(Defect)
+	}
+	return s.play(Cooperate)
+}
+func (s *StimulusResponse) Name() string { return "Stimulus Response" }
+func (s *StimulusResponse) Reset() {
+	s.ownMoves = s.ownMoves[:0]
+	s.responses, s.cooperations, s.seen = [2]int{}, [2]int{}, 0
+}
+func (s *StimulusResponse) SetPayoff(m PayoffMatrix) { s.payoff = m }
+func (s *StimulusResponse) State() string {
+	return fmt.Sprintf("p(C|C)=%.2f p(C|D)=%.2f", s.cooperationAfter(Cooperate), s.cooperationAfter(Defect))
+}
+
+// Parâmetros do LoopExploiter
+const (
+	loopWindow = 12 // Respostas recentes do oponente usadas para reconhecer o padrão
+)
+
+// loopProbe é a abertura do LoopExploiter: uma traição isolada e uma dupla, que separam os padrões
+// (Tit-for-Tat responde à isolada, Tit-for-Two-Tats só à dupla e Always Cooperate a nenhuma)
+var loopProbe = []Choice{Cooperate, Defect, Cooperate, Defect, Defect, Cooperate}
+
+// LoopPattern é um padrão de resposta determinístico do oponente, em função das duas últimas
+// jogadas de quem o enfrenta, com a melhor forma de explorá-lo
+type LoopPattern struct {
+	name    string
+	respond func(beforeLast, last Choice) Choice // Resposta prevista do oponente
+	exploit func(last Choice) Choice             // Melhor jogada contra o padrão, dada a própria jogada anterior
+}
+
+// loopPatterns são os padrões reconhecidos, do mais conservador ao mais agressivo: quando o
+// histórico é compatível com mais de um, vale o primeiro
+var loopPatterns = []LoopPattern{
+	{
+		// Tit-for-Tat: explorar só provoca retaliação, então o melhor é cooperar sempre
+		name:    "echo",
+		respond: func(beforeLast, last Choice) Choice { return last },
+		exploit: func(last Choice) Choice { return Cooperate },
+	},
+	{
+		// Tit-for-Two-Tats: uma traição isolada não é punida, então trai em rodadas alternadas
+		name: "two-echo",
+		respond: func(beforeLast, last Choice) Choice {
+			if beforeLast == Defect && last == Defect {
+				return Defect
+			}
+			return Cooperate
+		},
+		exploit: func(last Choice) Choice {
+			if last == Defect {
+				return Cooperate
+			}
+			return Defect
+		},
+	},
+	{
+		name:    "always-cooperate",
+		respond: func(beforeLast, last Choice) Choice { return Cooperate },
+		exploit: func(last Choice) Choice { return Defect },
+	},
+	{
+		name:    "always-defect",
+		respond: func(beforeLast, last Choice) Choice { return Defect },
+		exploit: func(last Choice) Choice { return Defect },
+	},
+}
+
+// LoopExploiter: Depois de uma abertura de sondagem, compara as respostas recentes do oponente
+// com padrões determinísticos conhecidos e, se um deles explica todas, joga a melhor sequência
+// contra ele; se nenhum explica, joga Tit-for-Tat
+type LoopExploiter struct {
+	ownHistory
+	pattern string // Padrão reconhecido na última rodada ("" se nenhum)
+}
+
+// detectLoop retorna o primeiro padrão que explica as últimas loopWindow respostas do oponente
+// às jogadas own, ou nil se nenhum explicar. A resposta da rodada i depende das jogadas i-2 e
+// i-1; jogadas anteriores ao início do jogo contam como cooperação. Os históricos são alinhados
+// pelo fim, já que com a janela de histórico o do oponente só tem as últimas rodadas
+func detectLoop(own, opponent []Choice) *LoopPattern {
+	offset := len(own) - len(opponent)
+	moveAt := func(i int) Choice {
+		if i += offset; i >= 0 && i < len(own) {
+			return own[i]
+		}
+		return Cooperate
+	}
+	start := max(len(opponent)-loopWindow, 0)
+	for k := range loopPatterns {
+		pattern := &loopPatterns[k]
+		matches := true
+		for i := start; i < len(opponent) && matches; i++ {
+			matches = pattern.respond(moveAt(i-2), moveAt(i-1)) == opponent[i]
+		}
+		if matches {
+			return pattern
+		}
+	}
+	return nil
+}
+
+func (s *LoopExploiter) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+	}
+	if len(s.ownMoves) < len(loopProbe) {
+		return s.play(loopProbe[len(s.ownMoves)])
+	}
+	if pattern := detectLoop(s.ownMoves, opponentMoves); pattern != nil {
+		s.pattern = pattern.name
+		return s.play(pattern.exploit(s.ownMoves[len(s.ownMoves)-1]))
+	}
+	s.pattern = ""
+	return s.play(opponentMoves[len(opponentMoves)-1])
+}
+func (s *LoopExploiter) Name() string { return "Loop Exploiter" }
+func (s *LoopExploiter) Reset()       { s.ownMoves, s.pattern = s.ownMoves[:0], "" }
+func (s *LoopExploiter) State() string {
+	return fmt.Sprintf("pattern=%q", s.pattern)
+}
+
+// Apologizer: Joga Tit-for-Tat, mas, quando trai um oponente que cooperou na mesma rodada (o
+// explora), pede desculpas: coopera na rodada seguinte e não retalia a traição com que o oponente
+// responder a essa exploração, evitando a espiral de retaliações
+type Apologizer struct {
+	ownHistory
+	forgiving bool // A próxima traição do oponente é a resposta merecida a uma exploração
+}
+
+func (s *Apologizer) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 || len(s.ownMoves) == 0 {
+		s.Reset()
+		return s.play(Cooperate)
+	}
+	lastOwn, lastOpponent := s.ownMoves[len(s.ownMoves)-1], opponentMoves[len(opponentMoves)-1]
+	if lastOwn == Defect && lastOpponent == Cooperate {
+		s.forgiving = true
+		return s.play(Cooperate)
+	}
+	if s.forgiving {
+		s.forgiving = false
+		if lastOpponent == Defect {
+			return s.play(Cooperate)
+		}
+	}
+	return s.play(lastOpponent)
+}
+func (s *Apologizer) Name() string { return "Apologizer" }
+func (s *Apologizer) Reset()       { s.ownMoves, s.forgiving = s.ownMoves[:0], false }
+func (s *Apologizer) State() string {
+	return fmt.Sprintf("forgiving=%t", s.forgiving)
+}
+
+// Parâmetros do Hardener
+const (
+	hardenerForgiveness = 0.6 // Probabilidade inicial de perdoar uma traição
+	hardenerDecay       = 0.7 // Fator aplicado ao perdão a cada exploração sofrida
+)
+
+// Hardener: Joga Tit-for-Tat, mas começa generoso, perdoando traições com frequência; cada vez que
+// é explorado (coopera e é traído, ganhando a pontuação de otário) fica menos disposto a perdoar
+type Hardener struct {
+	ownHistory
+	randomized
+	exploitations int // Rodadas em que cooperou e foi traído
+}
+
+// forgiveness retorna a probabilidade de perdoar uma traição depois de exploitations explorações,
+// que decresce geometricamente a partir de hardenerForgiveness
+func (s *Hardener) forgiveness() float64 {
+	return hardenerForgiveness * math.Pow(hardenerDecay, float64(s.exploitations))
+}
+
+func (s *Hardener) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 || len(s.ownMoves) == 0 {
+		s.Reset()
+		return s.play(Cooperate)
+	}
+	lastOwn, lastOpponent := s.ownMoves[len(s.ownMoves)-1], opponentMoves[len(opponentMoves)-1]
+	if lastOpponent == Cooperate {
+		return s.play(Cooperate)
+	}
+	if lastOwn == Cooperate {
+		s.exploitations++
+	}
+	if s.random().Float64() < s.forgiveness() {
+		return s.play(Cooperate)
+	}
+	return s.play(Defect)
+}
+func (s *Hardener) Name() string { return "Hardener" }
+func (s *Hardener) Reset()       { s.ownMoves, s.exploitations = s.ownMoves[:0], 0 }
+func (s *Hardener) State() string {
+	return fmt.Sprintf("exploitations=%d forgiveness=%.2f", s.exploitations, s.forgiveness())
+}
+
+// Maximin: Escolhe, a cada rodada, a jogada que garante a melhor pontuação no pior caso segundo a
+// matriz (no dilema do prisioneiro clássico, trair); em caso de empate, coopera
+type Maximin struct {
+	payoff PayoffMatrix
+}
+
+func (s *Maximin) NextMove(round int, opponentMoves []Choice) Choice {
+	worstCooperate := min(s.payoff.Reward, s.payoff.Sucker)
+	worstDefect := min(s.payoff.Temptation, s.payoff.Punishment)
+	if worstDefect > worstCooperate {
+		return Defect
+	}
+	return Cooperate
+}
+func (s *Maximin) Name() string             { return "Maximin" }
+func (s *Maximin) Stateless() bool          { return true }
+func (s *Maximin) SetPayoff(m PayoffMatrix) { s.payoff = m }
+
+// PrimeCooperator: Coopera nas rodadas cujo número (contando a partir de 1) é primo e trai nas
+// demais, ignorando o oponente: um padrão irregular, mas determinístico
+type PrimeCooperator struct{}
+
+func (s PrimeCooperator) NextMove(round int, opponentMoves []Choice) Choice {
+	if isPrime(round + 1) {
+		return Cooperate
+	}
+	return Defect
+}
+func (s PrimeCooperator) Name() string    { return "Prime Cooperator" }
+func (s PrimeCooperator) Stateless() bool { return true }
+
+// isPrime indica se n é primo, por divisão por tentativa até a raiz quadrada
+func isPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+	for d := 2; d*d <= n; d++ {
+		if n%d == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// chameleonWindow é o número de rodadas que o Chameleon observa antes de tentar reconhecer o oponente
+const chameleonWindow = 10
+
+// classifyOpponent tenta reconhecer o oponente entre as estratégias registradas: cada estratégia
+// determinística é rejogada contra as jogadas de quem observa (ownMoves) e reconhecida se repetir
+// exatamente as jogadas do oponente, alinhadas pelo fim. Retorna o construtor da primeira
+// reconhecida, na ordem do registro, ou nil se nenhuma for. Estratégias estocásticas não são
+// candidatas, pois os sorteios não podem ser reproduzidos
+func classifyOpponent(ownMoves, opponentMoves []Choice, payoff PayoffMatrix, horizon int) func() Strategy {
+	n := len(opponentMoves)
+	if n == 0 || n > len(ownMoves) {
+		return nil
+	}
+	ownMoves = ownMoves[len(ownMoves)-n:]
+	for _, constructor := range strategyRegistry {
+		candidate := constructor()
+		if _, stochastic := candidate.(RandomAware); stochastic {
+			continue
+		}
+		if _, chameleon := candidate.(*Chameleon); chameleon {
+			continue
+		}
+		setStrategyPayoff(candidate, payoff)
+		setStrategyHorizon(candidate, horizon)
+		matches := true
+		for round := 0; round < n && matches; round++ {
+			matches = candidate.NextMove(round, ownMoves[:round]) == opponentMoves[round]
+		}
+		if matches {
+			return constructor
+		}
+	}
+	return nil
+}
+
+// Chameleon: Joga Tit-for-Tat nas primeiras rodadas enquanto observa o oponente; depois tenta
+// reconhecê-lo (veja classifyOpponent) e passa a imitá-lo, delegando a uma cópia nova da mesma
+// estratégia. Se não o reconhecer, continua com Tit-for-Tat
+type Chameleon struct {
+	ownHistory
+	payoff   PayoffMatrix
+	horizon  int
+	delegate Strategy // Estratégia imitada (nil durante a observação)
+}
+
+func (s *Chameleon) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return s.play(Cooperate)
+	}
+	if s.delegate == nil && len(s.ownMoves) < chameleonWindow {
+		return s.play(opponentMoves[len(opponentMoves)-1])
+	}
+	if s.delegate == nil {
+		s.delegate = TitForTat{}
+		if constructor := classifyOpponent(s.ownMoves, opponentMoves, s.payoff, s.horizon); constructor != nil {
+			s.delegate = constructor()
+			setStrategyPayoff(s.delegate, s.payoff)
+			setStrategyHorizon(s.delegate, s.horizon)
+		}
+		// Aquece a cópia com o histórico já jogado, como se ela estivesse no jogo desde o início
+		for r := range opponentMoves {
+			s.delegate.NextMove(r, opponentMoves[:r])
+		}
+	}
+	return s.play(s.delegate.NextMove(round, opponentMoves))
+}
+func (s *Chameleon) Name() string             { return "Chameleon" }
+func (s *Chameleon) Reset()                   { s.ownMoves, s.delegate = s.ownMoves[:0], nil }
+func (s *Chameleon) SetPayoff(m PayoffMatrix) { s.payoff = m }
+func (s *Chameleon) SetHorizon(rounds int)    { s.horizon = rounds }
+func (s *Chameleon) State() string {
+	if s.delegate == nil {
+		return "observing"
+	}
+	return "imitating=" + s.delegate.Name()
+}
+
+// Parâmetros do Annealer
+const (
+	annealerTemperature = 10.0 // Temperatura inicial, na escala dos pontos da matriz
+	annealerCooling     = 0.95 // Fator aplicado à temperatura a cada rodada
+	annealerMinimum     = 1e-3 // Temperatura mínima, para não dividir por zero
+)
+
+// Annealer: Escolhe as jogadas como um recozimento simulado: compara a pontuação média que cada
+// jogada lhe rendeu até agora e sorteia com probabilidade proporcional a exp(média/temperatura).
+// Com a temperatura alta das primeiras rodadas joga quase ao acaso (explora); à medida que ela
+// esfria, passa a jogar quase sempre a jogada de maior média (explora o que aprendeu)
+type Annealer struct {
+	ownHistory
+	randomized
+	payoff PayoffMatrix
+	points [2]int // Pontos obtidos com cada jogada
+	plays  [2]int // Vezes em que cada jogada foi avaliada
+}
+
+// temperature retorna a temperatura na rodada dada
+func (s *Annealer) temperature(round int) float64 {
+	return math.Max(annealerTemperature*math.Pow(annealerCooling, float64(round)), annealerMinimum)
+}
+
+// average retorna a pontuação média obtida com move, ou 0 se ela ainda não foi jogada
+func (s *Annealer) average(move Choice) float64 {
+	if s.plays[move] == 0 {
+		return 0
+	}
+	return float64(s.points[move]) / float64(s.plays[move])
+}
+
+func (s *Annealer) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 || len(s.ownMoves) == 0 {
+		s.Reset()
+	} else {
+		lastOwn := s.ownMoves[len(s.ownMoves)-1]
+		s.points[lastOwn] += s.payoff.Points(lastOwn, opponentMoves[len(opponentMoves)-1])
+		s.plays[lastOwn]++
+	}
+	// Probabilidade de Boltzmann de cooperar: 1 / (1 + exp((média(D) - média(C)) / T))
+	pCooperate := 1 / (1 + math.Exp((s.average(Defect)-s.average(Cooperate))/s.temperature(round)))
+	if s.random().Float64() < pCooperate {
+		return s.play(Cooperate)
+	}
+	return s.play(Defect)
+}
+func (s *Annealer) Name() string { return "Annealer" }
+func (s *Annealer) Reset() {
+	s.ownMoves = s.ownMoves[:0]
+	s.points, s.plays = [2]int{}, [2]int{}
+}
+func (s *Annealer) SetPayoff(m PayoffMatrix) { s.payoff = m }
+func (s *Annealer) State() string {
+	return fmt.Sprintf("avg(C)=%.2f avg(D)=%.2f", s.average(Cooperate), s.average(Defect))
+}
+
+// niceRetaliatorWindow é o número de rodadas recentes em que o NiceRetaliator confere suas retaliações
+const niceRetaliatorWindow = 10
+
+// NiceRetaliator: Trai apenas para retaliar, com regras verificáveis dos quatro princípios de
+// Axelrod: é gentil (só trai na rodada seguinte a uma traição do oponente, então nunca trai
+// primeiro), retaliador (responde a cada traição), proporcional (nas últimas rodadas, nunca trai
+// mais vezes do que o oponente traiu nas rodadas às quais respondia) e perdoa (volta a cooperar
+// assim que o oponente coopera)
+type NiceRetaliator struct {
+	ownHistory
+}
+
+func (s *NiceRetaliator) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 || len(s.ownMoves) == 0 {
+		s.Reset()
+		return s.play(Cooperate)
+	}
+	if opponentMoves[len(opponentMoves)-1] == Cooperate {
+		return s.play(Cooperate)
+	}
+	// A jogada desta rodada e as anteriores da janela respondem, cada uma, à jogada do oponente da
+	// rodada anterior a ela: só retalia se as retaliações ficarem dentro das traições respondidas
+	window := min(niceRetaliatorWindow, len(s.ownMoves), len(opponentMoves)-1)
+	retaliations := countMoves(s.ownMoves[len(s.ownMoves)-window:], Defect)
+	provocations := countMoves(opponentMoves[len(opponentMoves)-window-1:], Defect)
+	if retaliations < provocations {
+		return s.play(Defect)
+	}
+	return s.play(Cooperate)
+}
+func (s *NiceRetaliator) Name() string { return "Nice Retaliator" }
+func (s *NiceRetaliator) Reset()       { s.ownMoves = s.ownMoves[:0] }
+
+// timeHealerRounds é o número de rodadas após a última traição do oponente em que o TimeHealer
+// volta a cooperar com certeza
+const timeHealerRounds = 5
+
+// TimeHealer: Depois de uma traição do oponente, a probabilidade de cooperar cresce com o tempo
+// desde a última traição ("o tempo cura as feridas"): k rodadas depois, coopera com
+// probabilidade k/timeHealerRounds; sem traições, sempre coopera
+type TimeHealer struct {
+	randomized
+}
+
+// cooperationProbability retorna a probabilidade de cooperar dado o histórico do oponente
+func (s TimeHealer) cooperationProbability(opponentMoves []Choice) float64 {
+	for k := 1; k <= len(opponentMoves); k++ {
+		if opponentMoves[len(opponentMoves)-k] == Defect {
+			return math.Min(1, float64(k)/timeHealerRounds)
+		}
+	}
+	return 1
+}
+
+func (s TimeHealer) NextMove(round int, opponentMoves []Choice) Choice {
+	if s.random().Float64() < s.cooperationProbability(opponentMoves) {
+		return Cooperate
+	}
+	return Defect
+}
+func (s TimeHealer) Name() string    { return "Time Healer" }
+func (s TimeHealer) Stateless() bool { return true }
+
+// Parâmetros do ThresholdProber
+const (
+	proberRest     = 2 // Cooperações após cada rajada de traições, para observar a resposta do oponente
+	proberMaxBurst = 6 // Maior rajada testada; um oponente que a tolera é explorado com ela
+)
+
+// ThresholdProber: Descobre quantas traições seguidas o oponente tolera sem retaliar e o explora
+// logo abaixo desse limite. Depois de proberRest cooperações de abertura, joga ciclos de uma
+// rajada de traições seguida de proberRest cooperações, aumentando a rajada a cada ciclo sem
+// retaliação; na primeira retaliação, recua uma traição e repete essa rajada. Se o oponente
+// voltar a retaliar, recua mais; sem nenhuma traição tolerada, coopera e passa a jogar Tit-for-Tat
+type ThresholdProber struct {
+	burst   int  // Traições da rajada do ciclo atual (0 = joga Tit-for-Tat)
+	probing bool // Ainda aumentando a rajada até encontrar o limite
+	step    int  // Posição no ciclo atual
+}
+
+func (s *ThresholdProber) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+	}
+	if round < proberRest {
+		return Cooperate
+	}
+	if s.burst == 0 {
+		return opponentMoves[len(opponentMoves)-1]
+	}
+	if s.step == s.burst+proberRest {
+		// Fim do ciclo: as últimas jogadas do oponente respondem às da rajada e do descanso
+		responses := opponentMoves[max(0, len(opponentMoves)-(s.burst+proberRest-1)):]
+		retaliated := countMoves(responses, Defect) > 0
+		switch {
+		case retaliated:
+			s.burst, s.probing = s.burst-1, false
+		case s.probing && s.burst < proberMaxBurst:
+			s.burst++
+		default:
+			s.probing = false
+		}
+		s.step = 0
+		if s.burst == 0 {
+			return Cooperate // Oferta de paz antes de passar a Tit-for-Tat, para não prolongar a retaliação
+		}
+	}
+	s.step++
+	if s.step <= s.burst {
+		return Defect
+	}
+	return Cooperate
+}
+func (s *ThresholdProber) Name() string { return "Threshold Prober" }
+func (s *ThresholdProber) Reset()       { s.burst, s.probing, s.step = 1, true, 0 }
+func (s *ThresholdProber) State() string {
+	return fmt.Sprintf("burst=%d probing=%t", s.burst, s.probing)
+}
+
+// SelfRegulator: Ignora o oponente e regula a própria frequência de cooperação: a cada rodada
+// escolhe a jogada que deixa a fração de cooperações da partida mais perto do alvo (ex.: 0.6)
+type SelfRegulator struct {
+	ownHistory
+	target float64 // Fração de cooperações desejada, entre 0 e 1
+}
+
+// NewSelfRegulator cria a estratégia com a fração de cooperações alvo dada
+func NewSelfRegulator(target float64) *SelfRegulator {
+	return &SelfRegulator{target: target}
+}
+
+func (s *SelfRegulator) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+	}
+	// Cooperar deixa a fração mais perto do alvo se cooperações+0.5 não passa de alvo×jogadas
+	cooperations, moves := countMoves(s.ownMoves, Cooperate), len(s.ownMoves)+1
+	if float64(cooperations)+0.5 <= s.target*float64(moves) {
+		return s.play(Cooperate)
+	}
+	return s.play(Defect)
+}
+func (s *SelfRegulator) Name() string    { return "Self Regulator" }
+func (s *SelfRegulator) Reset()          { s.ownMoves = s.ownMoves[:0] }
+func (s *SelfRegulator) Clone() Strategy { return NewSelfRegulator(s.target) }
+func (s *SelfRegulator) State() string {
+	return fmt.Sprintf("coop=%.2f target=%.2f", cooperationRate(s.ownMoves), s.target)
+}
+
+// phaseWindow é quantas jogadas recentes do oponente o PhaseMatcher analisa, phaseMaxPeriod o
+// maior período procurado e phaseAgreement a fração mínima de rodadas que devem repetir a jogada
+// de um período antes para o padrão ser aceito
+const (
+	phaseWindow    = 24
+	phaseMaxPeriod = 6
+	phaseAgreement = 0.9
+)
+
+// detectPeriod procura, pela autocorrelação das jogadas recentes, o menor período em que o
+// histórico se repete: a fração de rodadas iguais à de period rodadas antes deve ser pelo menos
+// phaseAgreement, com o padrão visto ao menos duas vezes. Retorna 0 se não houver período
+func detectPeriod(moves []Choice) int {
+	recent := moves[max(0, len(moves)-phaseWindow):]
+	for period := 1; period <= phaseMaxPeriod && 2*period <= len(recent); period++ {
+		matches := 0
+		for t := period; t < len(recent); t++ {
+			if recent[t] == recent[t-period] {
+				matches++
+			}
+		}
+		if float64(matches) >= phaseAgreement*float64(len(recent)-period) {
+			return period
+		}
+	}
+	return 0
+}
+
+// PhaseMatcher: Detecta o período do padrão de jogadas do oponente (ex.: Alternator tem período
+// 2) e alinha a própria cooperação às fases em que ele coopera, traindo nas fases em que ele trai;
+// sem padrão detectado, joga Tit-for-Tat
+type PhaseMatcher struct{}
+
+func (s PhaseMatcher) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		return Cooperate
+	}
+	if period := detectPeriod(opponentMoves); period > 0 {
+		// A fase da próxima rodada é a mesma de period rodadas antes
+		return opponentMoves[len(opponentMoves)-period]
+	}
+	return opponentMoves[len(opponentMoves)-1]
+}
+func (s PhaseMatcher) Name() string    { return "Phase Matcher" }
+func (s PhaseMatcher) Stateless() bool { return true }
+
+// MinimaxRegret: Estima, de forma incremental, a probabilidade de o oponente cooperar (com uma
+// margem de incerteza que diminui com as rodadas) e escolhe a jogada de menor arrependimento no
+// pior caso: o arrependimento é quanto se deixa de ganhar em relação à melhor resposta à jogada do
+// oponente, ponderado pela probabilidade estimada. Em caso de empate, coopera
+type MinimaxRegret struct {
+	payoff       PayoffMatrix
+	cooperations int // Cooperações do oponente vistas até aqui
+	seen         int // Jogadas do oponente vistas até aqui
+}
+
+// regret retorna o arrependimento esperado de jogar own quando o oponente coopera com
+// probabilidade p
+func (s *MinimaxRegret) regret(own Choice, p float64) float64 {
+	m := s.payoff
+	bestIfCooperate := max(m.Reward, m.Temptation)
+	bestIfDefect := max(m.Sucker, m.Punishment)
+	return p*float64(bestIfCooperate-m.Points(own, Cooperate)) + (1-p)*float64(bestIfDefect-m.Points(own, Defect))
+}
+
+func (s *MinimaxRegret) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+	} else {
+		// Só a última jogada é nova: a estimativa é atualizada sem percorrer o histórico
+		s.seen++
+		if opponentMoves[len(opponentMoves)-1] == Cooperate {
+			s.cooperations++
+		}
+	}
+	// Estimativa de Laplace e a margem de incerteza em torno dela
+	estimate := float64(s.cooperations+1) / float64(s.seen+2)
+	margin := 1 / math.Sqrt(float64(s.seen+2))
+	low, high := math.Max(0, estimate-margin), math.Min(1, estimate+margin)
+	// O arrependimento esperado é linear em p, então o pior caso está em um dos extremos
+	worstCooperate := math.Max(s.regret(Cooperate, low), s.regret(Cooperate, high))
+	worstDefect := math.Max(s.regret(Defect, low), s.regret(Defect, high))
+	if worstDefect < worstCooperate {
+		return Defect
+	}
+	return Cooperate
+}
+func (s *MinimaxRegret) Name() string             { return "Minimax Regret" }
+func (s *MinimaxRegret) Reset()                   { s.cooperations, s.seen = 0, 0 }
+func (s *MinimaxRegret) SetPayoff(m PayoffMatrix) { s.payoff = m }
+
+// bayesianArchetypes são os tipos de oponente considerados pelo BayesianPlayer, na ordem usada
+// para desempatar; bayesianNoise é a chance de um tipo determinístico fugir do próprio padrão e
+// bayesianProbeRound a rodada da traição de teste
+var bayesianArchetypes = []string{"Tit-for-Tat", "Always Cooperate", "Always Defect", "Random"}
+
+const (
+	bayesianNoise      = 0.01
+	bayesianProbeRound = 1
+)
+
+// BayesianPlayer: Mantém uma distribuição a posteriori sobre quatro tipos de oponente (Tit-for-Tat,
+// Always Cooperate, Always Defect e Random), atualizada pela regra de Bayes a cada jogada observada,
+// e joga a melhor resposta ao tipo mais provável: coopera com Tit-for-Tat e trai contra os demais.
+// Trai uma vez na segunda rodada, pois contra quem só coopera Tit-for-Tat e Always Cooperate jogam igual
+type BayesianPlayer struct {
+	ownHistory
+	posterior []float64 // Probabilidade de cada tipo, na ordem de bayesianArchetypes
+}
+
+// likelihood retorna a probabilidade de o tipo archetype jogar move na rodada round, dadas as
+// jogadas próprias anteriores
+func (s *BayesianPlayer) likelihood(archetype int, round int, move Choice) float64 {
+	expected := Cooperate
+	switch bayesianArchetypes[archetype] {
+	case "Random":
+		return 0.5
+	case "Always Defect":
+		expected = Defect
+	case "Tit-for-Tat":
+		if round > 0 {
+			expected = s.ownMoves[round-1]
+		}
+	}
+	if move == expected {
+		return 1 - bayesianNoise
+	}
+	return bayesianNoise
+}
+
+// update incorpora a jogada do oponente na rodada round à distribuição e a normaliza
+func (s *BayesianPlayer) update(round int, move Choice) {
+	total := 0.0
+	for i := range s.posterior {
+		s.posterior[i] *= s.likelihood(i, round, move)
+		total += s.posterior[i]
+	}
+	for i := range s.posterior {
+		s.posterior[i] /= total
+	}
+}
+
+// mostLikely retorna o índice do tipo mais provável (o primeiro, em caso de empate)
+func (s *BayesianPlayer) mostLikely() int {
+	best := 0
+	for i, p := range s.posterior {
+		if p > s.posterior[best] {
+			best = i
+		}
+	}
+	return best
+}
+
+func (s *BayesianPlayer) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+	} else if len(s.ownMoves) > 0 {
+		s.update(len(s.ownMoves)-1, opponentMoves[len(opponentMoves)-1])
+	}
+	if round != bayesianProbeRound && bayesianArchetypes[s.mostLikely()] == "Tit-for-Tat" {
+		return s.play(Cooperate)
+	}
+	return s.play(Defect)
+}
+func (s *BayesianPlayer) Name() string { return "Bayesian Player" }
+func (s *BayesianPlayer) Reset() {
+	s.ownMoves = s.ownMoves[:0]
+	s.posterior = make([]float64, len(bayesianArchetypes))
+	for i := range s.posterior {
+		s.posterior[i] = 1 / float64(len(bayesianArchetypes))
+	}
+}
+func (s *BayesianPlayer) State() string {
+	parts := make([]string, len(bayesianArchetypes))
+	for i, name := range bayesianArchetypes {
+		parts[i] = fmt.Sprintf("%s=%.2f", name, s.posterior[i])
+	}
+	return strings.Join(parts, " ")
+}
+
+// FriedmanAmnesty: Grim Trigger com anistia: após a primeira traição do oponente trai sempre, mas
+// se ele ainda assim cooperar k rodadas seguidas durante a punição, perdoa e volta a cooperar
+type FriedmanAmnesty struct {
+	k         int  // Cooperações seguidas do oponente, durante a punição, que garantem a anistia
+	triggered bool // Punindo o oponente
+	streak    int  // Cooperações seguidas do oponente desde o início da punição
+}
+
+// NewFriedmanAmnesty cria a estratégia que concede anistia após k cooperações seguidas do oponente
+func NewFriedmanAmnesty(k int) *FriedmanAmnesty {
+	return &FriedmanAmnesty{k: k}
+}
+
+func (s *FriedmanAmnesty) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return Cooperate
+	}
+	lastMove := opponentMoves[len(opponentMoves)-1]
+	if !s.triggered {
+		if lastMove == Defect {
+			s.triggered, s.streak = true, 0
+			return Defect
+		}
+		return Cooperate
+	}
+	if lastMove == Cooperate {
+		s.streak++
+	} else {
+		s.streak = 0
+	}
+	if s.streak >= s.k {
+		s.triggered, s.streak = false, 0
+		return Cooperate
+	}
+	return Defect
+}
+func (s *FriedmanAmnesty) Name() string    { return "Friedman (Amnesty)" }
+func (s *FriedmanAmnesty) Reset()          { s.triggered, s.streak = false, 0 }
+func (s *FriedmanAmnesty) Clone() Strategy { return NewFriedmanAmnesty(s.k) }
+func (s *FriedmanAmnesty) State() string {
+	return fmt.Sprintf("triggered=%t streak=%d/%d", s.triggered, s.streak, s.k)
+}
+
+// EscalatingPunisher: Coopera, mas retalia cada traição do oponente com uma rajada de traições
+// cada vez mais longa: uma na primeira traição, duas na segunda, três na terceira e assim por
+// diante, voltando a cooperar ao fim de cada rajada. Traições durante uma rajada são contadas, mas
+// não começam outra
+type EscalatingPunisher struct {
+	defections int // Traições do oponente vistas até aqui
+	burst      int // Traições restantes da rajada atual
+}
+
+func (s *EscalatingPunisher) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return Cooperate
+	}
+	if opponentMoves[len(opponentMoves)-1] == Defect {
+		s.defections++
+		if s.burst == 0 {
+			s.burst = s.defections
+		}
+	}
+	if s.burst > 0 {
+		s.burst--
+		return Defect
+	}
+	return Cooperate
+}
+func (s *EscalatingPunisher) Name() string { return "Escalating Punisher" }
+func (s *EscalatingPunisher) Reset()       { s.defections, s.burst = 0, 0 }
+func (s *EscalatingPunisher) State() string {
+	return fmt.Sprintf("defections=%d burst=%d", s.defections, s.burst)
+}
+
+// chaosHash embaralha os bits de x (função "lowbias32"): barata, sem gerador aleatório e com o
+// mesmo resultado em qualquer plataforma, por usar só aritmética de 32 bits sem sinal
+func chaosHash(x uint32) uint32 {
+	x ^= x >> 16
+	x *= 0x7feb352d
+	x ^= x >> 15
+	x *= 0x846ca68b
+	x ^= x >> 16
+	return x
+}
+
+// DeterministicChaos: Coopera nas rodadas cujo hash do número é par e trai nas demais: parece
+// aleatória, mas a sequência é sempre a mesma, sem depender de nenhum gerador aleatório
+type DeterministicChaos struct{}
+
+func (s DeterministicChaos) NextMove(round int, opponentMoves []Choice) Choice {
+	if chaosHash(uint32(round))%2 == 0 {
+		return Cooperate
+	}
+	return Defect
+}
+func (s DeterministicChaos) Name() string    { return "Deterministic Chaos" }
+func (s DeterministicChaos) Stateless() bool { return true }
+
+// RatioTargeter: Tenta manter a própria pontuação em um múltiplo fixo da do oponente (ex.: 1.5
+// vez). Supondo que o oponente repita a última jogada, escolhe a jogada que deixa o placar mais
+// perto da proporção alvo: na prática, trai quando está abaixo dela e coopera quando está acima
+type RatioTargeter struct {
+	ownHistory
+	payoff PayoffMatrix
+	target float64 // Pontuação própria desejada, como múltiplo da do oponente
+}
+
+// NewRatioTargeter cria a estratégia com a proporção alvo dada, usando a matriz padrão até o jogo
+// informar outra
+func NewRatioTargeter(target float64) *RatioTargeter {
+	return &RatioTargeter{payoff: defaultPayoff, target: target}
+}
+
+// gap mede a distância do placar até a proporção alvo, sem dividir (o oponente pode ter 0 pontos)
+func (s *RatioTargeter) gap(own, opponent int) float64 {
+	return math.Abs(float64(own) - s.target*float64(opponent))
+}
+
+func (s *RatioTargeter) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return s.play(Cooperate)
+	}
+	own, opponent := runningScores(s.ownMoves, opponentMoves, s.payoff)
+	predicted := opponentMoves[len(opponentMoves)-1]
+	ifCooperate := s.gap(own+s.payoff.Points(Cooperate, predicted), opponent+s.payoff.Points(predicted, Cooperate))
+	ifDefect := s.gap(own+s.payoff.Points(Defect, predicted), opponent+s.payoff.Points(predicted, Defect))
+	if ifDefect < ifCooperate {
+		return s.play(Defect)
+	}
+	return s.play(Cooperate)
+}
+func (s *RatioTargeter) Name() string             { return "Ratio Targeter" }
+func (s *RatioTargeter) Reset()                   { s.ownMoves = s.ownMoves[:0] }
+func (s *RatioTargeter) SetPayoff(m PayoffMatrix) { s.payoff = m }
+func (s *RatioTargeter) Clone() Strategy {
+	clone := NewRatioTargeter(s.target)
+	clone.payoff = s.payoff
+	return clone
+}
+
+// testerVerdictRounds é quantas rodadas depois de um teste o OccasionalTester espera para julgar
+// se o oponente perdoou: uma retaliação imediata é tolerada, desde que ele volte a cooperar
+const testerVerdictRounds = 2
+
+// OccasionalTester: Joga Tit-for-Tat, mas, depois de minStreak rodadas seguidas de cooperação
+// mútua, trai uma única vez com probabilidade p para testar a paciência do oponente e volta logo a
+// cooperar. Se o oponente não perdoar (ainda trair testerVerdictRounds rodadas depois do teste),
+// nunca mais testa
+type OccasionalTester struct {
+	randomized
+	ownHistory
+	p         float64
+	minStreak int
+	testRound int  // Rodada do teste aguardando resposta; -1 se nenhum
+	gaveUp    bool // O oponente não perdoou um teste
+}
+
+// NewOccasionalTester cria a estratégia que testa com probabilidade p depois de minStreak
+// cooperações mútuas seguidas
+func NewOccasionalTester(p float64, minStreak int) *OccasionalTester {
+	return &OccasionalTester{p: p, minStreak: minStreak, testRound: -1}
+}
+
+// cooperationStreak conta as rodadas seguidas de cooperação mútua, a partir da última
+func (s *OccasionalTester) cooperationStreak(opponentMoves []Choice) int {
+	streak := 0
+	for own, opp := len(s.ownMoves)-1, len(opponentMoves)-1; own >= 0 && opp >= 0; own, opp = own-1, opp-1 {
+		if s.ownMoves[own] != Cooperate || opponentMoves[opp] != Cooperate {
+			break
+		}
+		streak++
+	}
+	return streak
+}
+
+func (s *OccasionalTester) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == 0 || len(opponentMoves) == 0 {
+		s.Reset()
+		return s.play(Cooperate)
+	}
+	if s.testRound >= 0 {
+		verdict := s.testRound + testerVerdictRounds
+		if round <= verdict {
+			// Ainda esperando a resposta: coopera sem retaliar a resposta ao próprio teste
+			return s.play(Cooperate)
+		}
+		// A jogada da rodada do veredito é contada a partir do fim: com janela de histórico, o
+		// oponente pode ter mais rodadas do que as entregues
+		if back := round - verdict; back <= len(opponentMoves) && opponentMoves[len(opponentMoves)-back] == Defect {
+			s.gaveUp = true
+		}
+		s.testRound = -1
+	}
+	if !s.gaveUp && s.minStreak > 0 && s.cooperationStreak(opponentMoves) >= s.minStreak && s.random().Float64() < s.p {
+		s.testRound = round
+		return s.play(Defect)
+	}
+	return s.play(opponentMoves[len(opponentMoves)-1])
+}
+func (s *OccasionalTester) Name() string { return "Occasional Tester" }
+func (s *OccasionalTester) Reset() {
+	s.ownMoves = s.ownMoves[:0]
+	s.testRound, s.gaveUp = -1, false
+}
+func (s *OccasionalTester) Clone() Strategy { return NewOccasionalTester(s.p, s.minStreak) }
+
+// HumanStrategy: Jogadas de um jogador humano, recebidas por um canal (ex.: cliques em botões)
+type HumanStrategy struct {
+	moves <-chan Choice
+}
+
+// NewHumanStrategy cria um jogador humano que joga cada valor recebido em moves
+func NewHumanStrategy(moves <-chan Choice) *HumanStrategy {
+	return &HumanStrategy{moves: moves}
+}
+
+func (s *HumanStrategy) NextMove(round int, opponentMoves []Choice) Choice {
+	// Bloqueia até o humano escolher a jogada desta rodada
+	return <-s.moves
+}
+func (s *HumanStrategy) Name() string { return tr("you") }
+
+// strategyRegistry lista os construtores das estratégias disponíveis, na ordem exibida na interface
+var strategyRegistry = []func() Strategy{
+	func() Strategy { return TitForTat{} },
+	func() Strategy { return &Random{} },
+	func() Strategy { return TidemanChieruzzi{} },
+	func() Strategy { return Nydegger{} },
+	func() Strategy { return Grofman{} },
+	func() Strategy { return &Shubik{} },
+	func() Strategy { return &SteinRapoport{} },
+	func() Strategy { return &Friedman{} },
+	func() Strategy { return Davis{} },
+	func() Strategy { return Graaskamp{} },
+	func() Strategy { return &Downing{} },
+	func() Strategy { return &Feld{} },
+	func() Strategy { return &Joss{} },
+	func() Strategy { return &Tullock{} },
+	func() Strategy { return &NameWithheld{} },
+	func() Strategy { return Alternator{} },
+	func() Strategy { return AlwaysCooperate{} },
+	func() Strategy { return AlwaysDefect{} },
+	func() Strategy { return &FrequencyModeler{} },
+	func() Strategy { return &ForgivingPavlov{payoff: defaultPayoff} },
+	func() Strategy { return LaggedTitForTat{} },
+	func() Strategy { return &ProportionalRetaliator{} },
+	func() Strategy { return &ReputationStrategy{threshold: 0.5} },
+	func() Strategy { return &Reflective{} },
+	func() Strategy { return &FairnessEnforcer{payoff: defaultPayoff} },
+	func() Strategy { return NewEndgamer(10, 1) },
+	func() Strategy { return NewPeaceOffering(3) },
+	func() Strategy { return NewWeightedMajority(0.8) },
+	func() Strategy { return &Negotiator{} },
+	func() Strategy { return NewMetaLearner(0.5, 0.05) },
+	func() Strategy { return NewCatchUp(10) },
+	func() Strategy { return NewQLearner(1, 0.1, 0.1) },
+	func() Strategy { return &StimulusResponse{payoff: defaultPayoff} },
+	func() Strategy { return &LoopExploiter{} },
+	func() Strategy { return &Apologizer{} },
+	func() Strategy { return &Hardener{} },
+	func() Strategy { return &Maximin{payoff: defaultPayoff} },
+	func() Strategy { return PrimeCooperator{} },
+	func() Strategy { return &Chameleon{payoff: defaultPayoff} },
+	func() Strategy { return &Annealer{payoff: defaultPayoff} },
+	func() Strategy { return &NiceRetaliator{} },
+	func() Strategy { return NewNeuralStrategy(titForTatWeights) },
+	func() Strategy { return &TimeHealer{} },
+	func() Strategy { return &ThresholdProber{} },
+	func() Strategy { return NewSelfRegulator(0.6) },
+	func() Strategy { return PhaseMatcher{} },
+	func() Strategy { return &MinimaxRegret{payoff: defaultPayoff} },
+	func() Strategy { return &BayesianPlayer{} },
+	func() Strategy { return NewFriedmanAmnesty(5) },
+	func() Strategy { return &EscalatingPunisher{} },
+	func() Strategy { return DeterministicChaos{} },
+	func() Strategy { return NewRatioTargeter(1.5) },
+	func() Strategy { return NewOccasionalTester(0.05, 10) },
+}
+
+// newStrategies cria instâncias novas de todas as estratégias registradas
+func newStrategies() []Strategy {
+	strategies := make([]Strategy, len(strategyRegistry))
+	for i, newFn := range strategyRegistry {
+		strategies[i] = newFn()
+	}
+	return strategies
+}
+
+// newStrategy cria uma instância nova da estratégia registrada com o nome dado (nil se não existir)
+func newStrategy(name string) Strategy {
+	for _, newFn := range strategyRegistry {
+		if s := newFn(); s.Name() == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// Limites do número de rodadas das partidas sorteadas pela "estratégia do dia"
+const (
+	matchupMinRounds = 20
+	matchupMaxRounds = 300
+)
+
+// Matchup é uma partida sorteada: duas estratégias registradas e o número de rodadas
+type Matchup struct {
+	nameA, nameB string
+	rounds       int
+}
+
+// randomMatchup sorteia com r duas estratégias registradas (diferentes, se houver mais de uma) e
+// um número de rodadas entre matchupMinRounds e matchupMaxRounds
+func randomMatchup(r *rand.Rand) Matchup {
+	a := r.Intn(len(strategyRegistry))
+	b := a
+	if len(strategyRegistry) > 1 {
+		// Sorteia entre as demais e pula a já escolhida
+		if b = r.Intn(len(strategyRegistry) - 1); b >= a {
+			b++
+		}
+	}
+	return Matchup{
+		nameA:  strategyRegistry[a]().Name(),
+		nameB:  strategyRegistry[b]().Name(),
+		rounds: matchupMinRounds + r.Intn(matchupMaxRounds-matchupMinRounds+1),
+	}
+}
+
+// freshInstance retorna uma instância nova de s para evitar estado compartilhado entre jogos
+func freshInstance(s Strategy) Strategy {
+	if c, ok := s.(Cloner); ok {
+		return c.Clone()
+	}
+	if fresh := newStrategy(s.Name()); fresh != nil {
+		return fresh
+	}
+	return s
+}
+
+// Game representa o estado do jogo
+type Game struct {
+	strategyA, strategyB Strategy
+	rounds               int
+	payoff               PayoffMatrix
+	scores               [2]int
+	movesA, movesB       []Choice
+	log                  io.Writer // Log de depuração (nil = desativado)
+	logLevel             LogLevel
+	coopBonus            int        // Bônus por rodada de cooperação mútua, multiplicado pelo tamanho da sequência (0 = desativado)
+	coopStreak           int        // Rodadas consecutivas de cooperação mútua até a rodada atual
+	defectEscalation     int        // Pontos a menos por rodada consecutiva de traição mútua (0 = desativado)
+	defectStreak         int        // Rodadas consecutivas de traição mútua até a rodada atual
+	historyWindow        int        // Rodadas do histórico entregues às estratégias (0 = todas)
+	handicap             [2]int     // Pontuação inicial de cada jogador (A, B), já incluída em scores
+	seeds                [2]int64   // Sementes dos geradores das estratégias (A, B), se seeded
+	seeded               bool       // As estratégias receberam geradores próprios (veja seedSides)
+	noise                float64    // Probabilidade de cada jogada ser trocada pela oposta (0 = sem ruído)
+	noiseSource          *rand.Rand // Gerador do ruído (nil = rng global)
+	forfeited            [2]bool    // Estratégias desclassificadas por entrar em pânico (A, B)
+	err                  error      // Por que o jogo foi interrompido (nil se não foi)
+}
+
+// NewGame cria um novo jogo com a matriz padrão, reiniciando o estado interno das estratégias
+func NewGame(strategyA, strategyB Strategy, rounds int) *Game {
+	resetStrategy(strategyA)
+	resetStrategy(strategyB)
+	g := &Game{
+		strategyA: strategyA,
+		strategyB: strategyB,
+		rounds:    rounds,
+		scores:    [2]int{0, 0},
+		movesA:    make([]Choice, 0, rounds),
+		movesB:    make([]Choice, 0, rounds),
+	}
+	g.SetPayoff(defaultPayoff)
+	setStrategyHorizon(strategyA, rounds)
+	setStrategyHorizon(strategyB, rounds)
+	return g
+}
+
+// SetPayoff troca a matriz de pontuação do jogo e a informa às estratégias que a consultam
+func (g *Game) SetPayoff(m PayoffMatrix) {
+	g.payoff = m
+	setStrategyPayoff(g.strategyA, m)
+	setStrategyPayoff(g.strategyB, m)
+}
+
+// SeedStrategies entrega a cada estratégia estocástica um gerador aleatório próprio, derivado de
+// seed e da posição dela no jogo, para que os sorteios de uma não alterem a sequência da outra
+func (g *Game) SeedStrategies(seed int64) {
+	g.seedSides(strategySeed(seed, 0), strategySeed(seed, 1))
+}
+
+// seedSides entrega às estratégias A e B geradores próprios com as sementes dadas
+func (g *Game) seedSides(seedA, seedB int64) {
+	g.seeds, g.seeded = [2]int64{seedA, seedB}, true
+	setStrategyRand(g.strategyA, rand.New(rand.NewSource(seedA)))
+	setStrategyRand(g.strategyB, rand.New(rand.NewSource(seedB)))
+}
+
+// SetHistoryWindow limita o histórico entregue às estratégias às últimas window rodadas (0 = sem
+// limite), para simular jogadores de memória limitada. O estado interno que a estratégia guarda
+// por conta própria (como as próprias jogadas) não é limitado
+func (g *Game) SetHistoryWindow(window int) {
+	g.historyWindow = window
+}
+
+// SetNoise faz cada jogada ser trocada pela oposta com probabilidade p, como um erro de execução:
+// a estratégia escolhe uma jogada e o histórico registra a outra. Sorteia com source ou, se ele for
+// nil, com o rng global
+func (g *Game) SetNoise(p float64, source *rand.Rand) {
+	g.noise, g.noiseSource = p, source
+}
+
+// applyNoise retorna a jogada, trocada pela oposta com a probabilidade do ruído do jogo
+func (g *Game) applyNoise(move Choice) Choice {
+	if g.noise <= 0 {
+		return move
+	}
+	source := g.noiseSource
+	if source == nil {
+		source = rng
+	}
+	if source.Float64() >= g.noise {
+		return move
+	}
+	if move == Cooperate {
+		return Defect
+	}
+	return Cooperate
+}
+
+// visibleHistory retorna a parte do histórico que as estratégias podem ver
+func (g *Game) visibleHistory(moves []Choice) []Choice {
+	if g.historyWindow <= 0 || len(moves) <= g.historyWindow {
+		return moves
+	}
+	return moves[len(moves)-g.historyWindow:]
+}
+
+// SetCooperationBonus ativa um bônus crescente para sequências de cooperação mútua: a n-ésima
+// rodada consecutiva em que ambos cooperam rende rate*n pontos extras a cada jogador
+func (g *Game) SetCooperationBonus(rate int) {
+	g.coopBonus = rate
+}
+
+// SetDefectionEscalation ativa uma penalidade crescente para sequências de traição mútua: a
+// n-ésima rodada consecutiva em que ambos traem rende rate*(n-1) pontos a menos a cada jogador,
+// sem nunca render menos que a pontuação de otário (a traição mútua não fica pior que ser explorado)
+func (g *Game) SetDefectionEscalation(rate int) {
+	g.defectEscalation = rate
+}
+
+// SetStartingScores faz o jogo começar com a pontuação dada para cada jogador, para mostrar como
+// uma vantagem inicial se desenrola; deve ser chamada antes da primeira rodada. A pontuação inicial
+// conta para o placar (e para quem vence), mas não é repassada às estratégias
+func (g *Game) SetStartingScores(scoreA, scoreB int) {
+	g.handicap = [2]int{scoreA, scoreB}
+	g.scores = g.handicap
+}
+
+// safeNextMove pede a próxima jogada à estratégia, convertendo um pânico dela (por exemplo, de
+// um plugin com defeito) em erro em vez de derrubar o programa
+func safeNextMove(s Strategy, round int, opponentMoves []Choice) (move Choice, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf(tr("err_strategy_panic"), s.Name(), round+1, r)
+		}
+	}()
+	return s.NextMove(round, opponentMoves), nil
+}
+
+// PlayRound joga uma rodada. Se uma estratégia entrar em pânico, ela é desclassificada (fica com
+// zero pontos), o jogo é encerrado e o erro é retornado, nesta e nas próximas chamadas
+func (g *Game) PlayRound(round int) error {
+	if g.err != nil {
+		return g.err
+	}
+	moveA, errA := safeNextMove(g.strategyA, round, g.visibleHistory(g.movesB))
+	moveB, errB := safeNextMove(g.strategyB, round, g.visibleHistory(g.movesA))
+	if errA != nil || errB != nil {
+		g.forfeited = [2]bool{errA != nil, errB != nil}
+		for i, forfeited := range g.forfeited {
+			if forfeited {
+				g.scores[i] = 0
+			}
+		}
+		g.err = errors.Join(errA, errB)
+		return g.err
+	}
+	moveA, moveB = g.applyNoise(moveA), g.applyNoise(moveB)
+
+	g.movesA = append(g.movesA, moveA)
+	g.movesB = append(g.movesB, moveB)
+
+	// Calcula pontuação
+	g.scores[0] += g.payoff.Points(moveA, moveB)
+	g.scores[1] += g.payoff.Points(moveB, moveA)
+
+	// Bônus pela sequência de cooperação mútua, se ativado
+	if moveA == Cooperate && moveB == Cooperate {
+		g.coopStreak++
+		g.scores[0] += g.coopBonus * g.coopStreak
+		g.scores[1] += g.coopBonus * g.coopStreak
+	} else {
+		g.coopStreak = 0
+	}
+
+	// Penalidade pela sequência de traição mútua, se ativada
+	if moveA == Defect && moveB == Defect {
+		g.defectStreak++
+		penalty := min(g.defectEscalation*(g.defectStreak-1), max(0, g.payoff.Punishment-g.payoff.Sucker))
+		g.scores[0] -= penalty
+		g.scores[1] -= penalty
+	} else {
+		g.defectStreak = 0
+	}
+	g.logRound(round)
+	return nil
+}
+
+// ScoreRace é o resultado de um jogo disputado até uma pontuação alvo
+type ScoreRace struct {
+	rounds  int  // Rodadas jogadas
+	reached bool // Se alguém atingiu o alvo (senão o jogo parou no limite de rodadas)
+	winner  int  // 0 = A, 1 = B, -1 = empate ou ninguém atingiu o alvo
+}
+
+// reachedScore informa se algum dos jogadores já atingiu a pontuação alvo
+func (g *Game) reachedScore(target int) bool {
+	return g.scores[0] >= target || g.scores[1] >= target
+}
+
+// scoreRace resume o jogo como uma disputa até target: se os dois atingiram o alvo na mesma
+// rodada, vence quem tem mais pontos, e a igualdade é empate
+func (g *Game) scoreRace(target int) ScoreRace {
+	race := ScoreRace{rounds: len(g.movesA), reached: g.reachedScore(target), winner: -1}
+	if race.reached && g.scores[0] != g.scores[1] {
+		race.winner = 0
+		if g.scores[1] > g.scores[0] {
+			race.winner = 1
+		}
+	}
+	return race
+}
+
+// playTowardScore joga a próxima rodada, a menos que o jogo já tenha acabado: maxRounds rodadas
+// jogadas ou, com target maior que zero, algum jogador com target pontos. Retorna false quando não
+// há mais rodadas a jogar; o erro de uma rodada (pânico de uma estratégia) também encerra o jogo
+func (g *Game) playTowardScore(target, maxRounds int) (bool, error) {
+	round := len(g.movesA)
+	if round >= maxRounds || (target > 0 && g.reachedScore(target)) {
+		return false, nil
+	}
+	if err := g.PlayRound(round); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RunUntilScore joga rodadas até que um dos jogadores atinja target pontos ou até o total de
+// maxRounds rodadas (limite de segurança), o que vier primeiro; target zero joga todas as rodadas
+func (g *Game) RunUntilScore(target, maxRounds int) (ScoreRace, error) {
+	for {
+		played, err := g.playTowardScore(target, maxRounds)
+		if err != nil || !played {
+			return g.scoreRace(target), err
+		}
+	}
+}
+
+// cooperationRate retorna a fração de jogadas cooperativas (0 se não houver jogadas)
+func cooperationRate(moves []Choice) float64 {
+	if len(moves) == 0 {
+		return 0
+	}
+	coops := 0
+	for _, move := range moves {
+		if move == Cooperate {
+			coops++
+		}
+	}
+	return float64(coops) / float64(len(moves))
+}
+
+// countMoves retorna quantas jogadas de moves são iguais a move
+func countMoves(moves []Choice, move Choice) int {
+	count := 0
+	for _, m := range moves {
+		if m == move {
+			count++
+		}
+	}
+	return count
+}
+
+// roundData guarda uma rodada do histórico exibido no modo normal
+type roundData struct {
+	round   int
+	moveA   Choice
+	moveB   Choice
+	scoreA  int
+	scoreB  int
+	pointsA int // Pontos que A ganhou nesta rodada
+	pointsB int // Pontos que B ganhou nesta rodada
+}
+
+// appendRound adiciona ao histórico a última rodada jogada no jogo; os pontos da rodada são a
+// diferença em relação à anterior (ou à pontuação inicial), o que inclui o bônus de cooperação e a
+// penalidade por traição mútua quando estão ativos
+func appendRound(history []roundData, game *Game) []roundData {
+	i := len(history)
+	previousA, previousB := game.handicap[0], game.handicap[1]
+	if i > 0 {
+		previousA, previousB = history[i-1].scoreA, history[i-1].scoreB
+	}
+	return append(history, roundData{
+		round:   i + 1,
+		moveA:   game.movesA[i],
+		moveB:   game.movesB[i],
+		scoreA:  game.scores[0],
+		scoreB:  game.scores[1],
+		pointsA: game.scores[0] - previousA,
+		pointsB: game.scores[1] - previousB,
+	})
+}
+
+// moveVerb descreve a jogada em texto, para o resumo acessível
+func moveVerb(move Choice) string {
+	if move == Cooperate {
+		return tr("recap_cooperated")
+	}
+	return tr("recap_defected")
+}
+
+// matchRecap descreve a partida em frases simples, uma por rodada, sem depender de símbolos ou
+// cores. O placar de cada frase é o do jogo (com pontuação inicial, bônus e penalidades)
+func matchRecap(nameA, nameB string, history []roundData) []string {
+	recap := make([]string, 0, len(history))
+	for _, data := range history {
+		recap = append(recap, fmt.Sprintf(tr("recap_sentence"),
+			data.round, nameA, moveVerb(data.moveA), nameB, moveVerb(data.moveB), data.scoreA, data.scoreB))
+	}
+	return recap
+}
+
+// roundTooltip explica o resultado de uma rodada do histórico: as jogadas e os pontos que cada
+// jogador ganhou pela matriz m, mais os pontos efetivos quando bônus ou penalidades os alteraram
+func roundTooltip(data roundData, m PayoffMatrix) string {
+	pointsA, pointsB := m.Points(data.moveA, data.moveB), m.Points(data.moveB, data.moveA)
+	text := fmt.Sprintf(tr("tooltip_outcome"), moveVerb(data.moveA), moveVerb(data.moveB), pointsA, pointsB)
+	if data.pointsA != pointsA || data.pointsB != pointsB {
+		text += " " + fmt.Sprintf(tr("tooltip_adjusted"), data.pointsA, data.pointsB)
+	}
+	return text
+}
+
+// findFirst retorna o índice da primeira rodada do histórico que satisfaz pred, ou -1
+func findFirst(history []roundData, pred func(roundData) bool) int {
+	for i, data := range history {
+		if pred(data) {
+			return i
+		}
+	}
+	return -1
+}
+
+// opponentLabel retorna o nome do oponente exibido durante o jogo contra o humano: o nome real,
+// se revealed, ou um nome genérico nos exercícios de "adivinhe a estratégia"
+func opponentLabel(name string, revealed bool) string {
+	if revealed {
+		return name
+	}
+	return tr("hidden_opponent")
+}
+
+// formatOutcome monta o texto do resultado final de um jogo, com a margem de vitória
+// e a vantagem percentual sobre o perdedor, ou a pontuação compartilhada em caso de empate
+func formatOutcome(nameA, nameB string, scoreA, scoreB int) string {
+	var output strings.Builder
+	output.WriteString(tr("final_result") + "\n")
+	output.WriteString(fmt.Sprintf(tr("points_line")+"\n", nameA, scoreA))
+	output.WriteString(fmt.Sprintf(tr("points_line")+"\n", nameB, scoreB))
+
+	if scoreA == scoreB {
+		output.WriteString(fmt.Sprintf(tr("tie")+"\n", scoreA))
+		return output.String()
+	}
+
+	winner, winnerScore, loserScore := nameA, scoreA, scoreB
+	if scoreB > scoreA {
+		winner, winnerScore, loserScore = nameB, scoreB, scoreA
+	}
+	margin := winnerScore - loserScore
+	output.WriteString(fmt.Sprintf(tr("winner")+"\n", winner))
+	// Sem pontos do perdedor não há base para a vantagem percentual
+	if loserScore > 0 {
+		lead := float64(margin) / float64(loserScore) * 100
+		output.WriteString(fmt.Sprintf(tr("margin_lead")+"\n", margin, lead))
+	} else {
+		output.WriteString(fmt.Sprintf(tr("margin")+"\n", margin))
+	}
+	return output.String()
+}
+
+// max é uma função auxiliar para evitar índices negativos
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Config guarda as configurações editáveis pelo usuário, separadas dos widgets
+type Config struct {
+	StrategyA string
+	StrategyB string
+	Rounds    int
+	Payoff    PayoffMatrix
+	Noise     float64 // Probabilidade de cada jogada ser trocada pela oposta
+	Seed      int64   // Semente dos sorteios (0 = uma nova a cada partida)
+	Reps      int     // Repetições do torneio
+}
+
+// defaultConfig retorna as configurações padrão da aplicação
+func defaultConfig() Config {
+	return Config{
+		StrategyA: TitForTat{}.Name(),
+		StrategyB: Random{}.Name(),
+		Rounds:    200,
+		Payoff:    defaultPayoff,
+		Noise:     0,
+		Seed:      0,
+		Reps:      1,
+	}
+}
+
+// configStore mantém a configuração atual e guarda a anterior para permitir desfazer uma edição
+type configStore struct {
+	current  Config
+	previous *Config
+}
+
+// newConfigStore cria um configStore iniciado com as configurações padrão
+func newConfigStore() *configStore {
+	return &configStore{current: defaultConfig()}
+}
+
+// Set altera a configuração atual, guardando a anterior para desfazer
+func (c *configStore) Set(cfg Config) {
+	if cfg == c.current {
+		return
+	}
+	prev := c.current
+	c.previous = &prev
+	c.current = cfg
+}
+
+// Reset restaura as configurações padrão (a alteração também pode ser desfeita)
+func (c *configStore) Reset() {
+	c.Set(defaultConfig())
+}
+
+// Undo desfaz a última alteração; retorna false se não houver o que desfazer
+func (c *configStore) Undo() bool {
+	if c.previous == nil {
+		return false
+	}
+	c.current = *c.previous
+	c.previous = nil
+	return true
+}
+
+// Result representa o resultado de uma estratégia no modo "todos contra todos"
+type Result struct {
+	name      string
+	score     int
+	games     int     // Jogos disputados (somando as cópias; o jogo contra si mesma conta duas vezes)
+	coopRate  float64 // Fração de jogadas cooperativas em todo o torneio
+	deviation float64 // Fração das jogadas que diferiram da previsão de Tit-for-Tat
+	rank      int     // Posição na classificação por pontuação
+	nice      bool    // Nunca foi a primeira a trair em nenhum jogo
+	stateless bool    // Não guarda estado entre rodadas além do histórico recebido
+	forfeits  int     // Jogos perdidos por desclassificação (pânico da estratégia)
+}
+
+// strategyCounts retorna quantas cópias de cada estratégia participam do torneio; estratégias
+// ausentes do mapa de pesos (ou com peso negativo) participam com uma cópia, e peso 0 as exclui
+func strategyCounts(strategies []Strategy, weights map[string]int) []int {
+	counts := make([]int, len(strategies))
+	for i, s := range strategies {
+		count, ok := weights[s.Name()]
+		if !ok || count < 0 {
+			count = 1
+		}
+		counts[i] = count
+	}
+	return counts
+}
+
+// heavyTournamentRounds é o total de rodadas a partir do qual o torneio pede confirmação
+const heavyTournamentRounds = 50_000_000
+
+// estimateWork estima o total de rodadas de um torneio com n participantes (cada um enfrenta
+// todos, incluindo a si mesmo), repetido reps vezes
+func estimateWork(n, reps, rounds int) int64 {
+	return int64(n) * int64(n) * int64(reps) * int64(rounds)
+}
+
+// formatThousands formata um número com pontos separando os milhares (ex.: 1.234.567)
+func formatThousands(n int64) string {
+	digits := strconv.FormatInt(n, 10)
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	var out strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			out.WriteByte('.')
+		}
+		out.WriteRune(digit)
+	}
+	return sign + out.String()
+}
+
+// TieBreak define como ordenar estratégias empatadas na pontuação total
+type TieBreak int
+
+const (
+	TieBreakCooperation  TieBreak = iota // Maior taxa de cooperação primeiro
+	TieBreakHeadToHead                   // Quem fez mais pontos no confronto direto primeiro
+	TieBreakAlphabetical                 // Ordem alfabética do nome
+)
+
+// tieBreakLabels descreve os critérios de desempate, na ordem das constantes, para a interface
+func tieBreakLabels() []string {
+	return []string{tr("tiebreak_cooperation"), tr("tiebreak_head_to_head"), tr("tiebreak_alphabetical")}
+}
+
+// ResultSort define a ordem de exibição da classificação do torneio
+type ResultSort int
+
+const (
+	SortByScore       ResultSort = iota // Classificação (maior pontuação primeiro)
+	SortByName                          // Ordem alfabética do nome
+	SortByCooperation                   // Maior taxa de cooperação primeiro
+)
+
+// ResultFilter define quais estratégias aparecem na classificação do torneio
+type ResultFilter int
+
+const (
+	FilterAll       ResultFilter = iota // Todas as estratégias
+	FilterNice                          // Só as que nunca traíram primeiro
+	FilterStateless                     // Só as sem estado interno
+)
+
+// ScoreScale define como as pontuações da classificação são exibidas; as médias permitem comparar
+// torneios com números de rodadas ou de participantes diferentes
+type ScoreScale int
+
+const (
+	ScaleTotal    ScoreScale = iota // Pontuação total
+	ScalePerMatch                   // Média por jogo
+	ScalePerRound                   // Média por rodada
+)
+
+// resultSortLabels, resultFilterLabels e scoreScaleLabels descrevem as opções, na ordem das
+// constantes, para a interface
+func resultSortLabels() []string {
+	return []string{tr("sort_score"), tr("sort_name"), tr("sort_cooperation")}
+}
+func resultFilterLabels() []string {
+	return []string{tr("filter_all"), tr("filter_nice"), tr("filter_stateless")}
+}
+func scoreScaleLabels() []string {
+	return []string{tr("scale_total"), tr("scale_per_match"), tr("scale_per_round")}
+}
+
+// perRoundScore normaliza uma pontuação total pelo número de rodadas de cada jogo e de jogos
+// disputados (oponentes enfrentados)
+func perRoundScore(total, rounds, opponents int) float64 {
+	if rounds <= 0 || opponents <= 0 {
+		return 0
+	}
+	return float64(total) / float64(rounds*opponents)
+}
+
+// formatScore formata a pontuação do resultado na escala dada, para torneios de rounds rodadas
+func formatScore(result Result, scale ScoreScale, rounds int) string {
+	switch scale {
+	case ScalePerMatch:
+		return fmt.Sprintf(tr("score_per_match"), perRoundScore(result.score, 1, result.games))
+	case ScalePerRound:
+		return fmt.Sprintf(tr("score_per_round"), perRoundScore(result.score, rounds, result.games))
+	}
+	return fmt.Sprintf(tr("score_total"), result.score)
+}
+
+// sortFilterResults retorna uma cópia dos resultados filtrada e ordenada conforme pedido, sem
+// alterar a classificação original (cada resultado mantém sua posição em rank)
+func sortFilterResults(results []Result, by ResultSort, filter ResultFilter) []Result {
+	view := make([]Result, 0, len(results))
+	for _, result := range results {
+		if (filter == FilterNice && !result.nice) || (filter == FilterStateless && !result.stateless) {
+			continue
+		}
+		view = append(view, result)
+	}
+	sort.SliceStable(view, func(i, j int) bool {
+		a, b := view[i], view[j]
+		switch by {
+		case SortByName:
+			return a.name < b.name
+		case SortByCooperation:
+			if a.coopRate != b.coopRate {
+				return a.coopRate > b.coopRate
+			}
+		}
+		return a.rank < b.rank
+	})
+	return view
+}
+
+// TournamentConfig reúne as opções de um torneio "todos contra todos"
+type TournamentConfig struct {
+	Rounds   int
+	Weights  map[string]int // Cópias de cada estratégia (nil = uma de cada)
+	TieBreak TieBreak
+	Seed     int64 // Se diferente de zero, cada confronto é semeado a partir dela (reproduzível e retomável)
+
+	// Shuffle embaralha, a partir de Seed, a ordem das estratégias antes de montar os confrontos.
+	// Como as sementes dos confrontos dependem só de quem joga (veja pairingSeed), o resultado não
+	// deve mudar: uma mudança denuncia uma estratégia que depende da ordem dos jogos
+	Shuffle bool
+
+	// HistoryWindow limita o histórico entregue às estratégias às últimas rodadas (0 = sem limite)
+	HistoryWindow int
+
+	// PersistentLearners mantém uma única instância de cada estratégia Learner ao longo do torneio,
+	// em vez de recriá-la a cada jogo; o aprendizado não é salvo nos checkpoints
+	PersistentLearners bool
+
+	// Payoff é a matriz de pontuação dos jogos; a matriz zero usa a padrão
+	Payoff PayoffMatrix
+
+	// Noise é a probabilidade de cada jogada ser trocada pela oposta (0 = sem ruído); com Seed, o
+	// ruído de cada confronto é sorteado com um gerador próprio, semeado como as estratégias
+	Noise float64
+
+	// Live, se definido, recebe os totais parciais enquanto o torneio é disputado
+	Live *LiveStandings `json:"-"`
+}
+
+// payoff retorna a matriz dos jogos do torneio
+func (cfg TournamentConfig) payoff() PayoffMatrix {
+	if cfg.Payoff == (PayoffMatrix{}) {
+		return defaultPayoff
+	}
+	return cfg.Payoff
+}
+
+// tournamentSettings resume a configuração que produziu um torneio de reps repetições (a matriz
+// de pontuação, o ruído, a semente e as demais opções) para que o
+// resultado divulgado possa ser reproduzido
+func tournamentSettings(cfg TournamentConfig, reps int) string {
+	m := cfg.payoff()
+	var b strings.Builder
+	b.WriteString(tr("settings_header") + "\n")
+	b.WriteString(fmt.Sprintf(tr("settings_rounds")+"\n", cfg.Rounds, reps))
+	b.WriteString(fmt.Sprintf(tr("settings_payoff")+"\n", m.Reward, m.Sucker, m.Temptation, m.Punishment))
+	if cfg.Noise > 0 {
+		b.WriteString(fmt.Sprintf(tr("settings_noise_level")+"\n", 100*cfg.Noise))
+	} else {
+		b.WriteString(tr("settings_noise") + "\n")
+	}
+	if cfg.Seed != 0 {
+		b.WriteString(fmt.Sprintf(tr("settings_seed")+"\n", cfg.Seed))
+	} else {
+		b.WriteString(tr("settings_no_seed") + "\n")
+	}
+	if cfg.Shuffle {
+		b.WriteString(tr("settings_shuffle") + "\n")
+	}
+	if labels := tieBreakLabels(); int(cfg.TieBreak) < len(labels) {
+		b.WriteString(fmt.Sprintf(tr("settings_tiebreak")+"\n", labels[cfg.TieBreak]))
+	}
+	if cfg.HistoryWindow > 0 {
+		b.WriteString(fmt.Sprintf(tr("settings_history_window")+"\n", cfg.HistoryWindow))
+	}
+	if cfg.PersistentLearners {
+		b.WriteString(tr("settings_persistent") + "\n")
+	}
+
+	// Só as estratégias com um número de cópias diferente do padrão, em ordem alfabética
+	var copies []string
+	for name, count := range cfg.Weights {
+		if count != 1 {
+			copies = append(copies, fmt.Sprintf("%s ×%d", name, count))
+		}
+	}
+	if len(copies) > 0 {
+		sort.Strings(copies)
+		b.WriteString(fmt.Sprintf(tr("settings_copies")+"\n", strings.Join(copies, ", ")))
+	}
+	return b.String()
+}
+
+// runAllAgainstAll executa o modo "todos contra todos" e retorna os resultados e a matriz
+// de confrontos, onde matrix[i][j] é o total de pontos que strategies[i] fez contra strategies[j]
+func runAllAgainstAll(strategies []Strategy, cfg TournamentConfig) ([]Result, [][]int) {
+	// Sem estratégias não há confrontos: retorna resultados vazios em vez de uma matriz inválida
+	if len(strategies) == 0 {
+		return []Result{}, [][]int{}
+	}
+	return playTournament(strategies, cfg).results(strategies)
+}
+
+// playTournament disputa todos os confrontos do torneio e retorna o checkpoint com os totais,
+// publicando a classificação parcial em cfg.Live, se definido
+func playTournament(strategies []Strategy, cfg TournamentConfig) *TournamentCheckpoint {
+	checkpoint := newTournamentCheckpoint(strategies, cfg)
+	if cfg.Live != nil {
+		advanceLive(checkpoint, strategies, cfg.Live)
+	} else {
+		checkpoint.advance(strategies, -1)
+	}
+	return checkpoint
+}
+
+// tournamentPairings expande as estratégias em cópias e lista, em ordem, os confrontos do
+// torneio como pares de índices das estratégias originais: cada cópia enfrenta todas as
+// outras, incluindo a si mesma, e cada par joga nas duas ordens (A contra B e B contra A),
+// o que já anula efeitos de posição na classificação. Com cfg.Shuffle, as cópias são
+// embaralhadas a partir de cfg.Seed
+func tournamentPairings(strategies []Strategy, cfg TournamentConfig) [][2]int {
+	var copies []int
+	for i, count := range strategyCounts(strategies, cfg.Weights) {
+		for c := 0; c < count; c++ {
+			copies = append(copies, i)
+		}
+	}
+	if cfg.Shuffle {
+		shuffler := rand.New(rand.NewSource(cfg.Seed))
+		shuffler.Shuffle(len(copies), func(a, b int) { copies[a], copies[b] = copies[b], copies[a] })
+	}
+	pairings := make([][2]int, 0, len(copies)*len(copies))
+	for _, i := range copies {
+		for _, j := range copies {
+			pairings = append(pairings, [2]int{i, j})
+		}
+	}
+	return pairings
+}
+
+// pairingSeed deriva a semente do gerador de uma estratégia em um confronto da semente do torneio,
+// do nome dela, do nome do oponente e de quantas vezes esse confronto já foi jogado (com cópias).
+// Por não depender da posição do confronto no torneio, a ordem dos jogos não altera os sorteios
+func pairingSeed(seed int64, name, opponent string, occurrence int) int64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d\x00%s\x00%s\x00%d", seed, name, opponent, occurrence)
+	return int64(h.Sum64())
+}
+
+// advance joga até limit confrontos ainda não disputados (todos, se limit for negativo),
+// acumulando os resultados no checkpoint; retorna true quando o torneio termina
+func (c *TournamentCheckpoint) advance(strategies []Strategy, limit int) bool {
+	pairings := tournamentPairings(strategies, c.Config)
+	reputation := c.reputation()
+	// Quantas vezes cada confronto (A contra B, pelos nomes) já foi jogado, para semear as cópias
+	occurrences := make(map[[2]string]int)
+	for _, pairing := range pairings[:c.Completed] {
+		occurrences[[2]string{strategies[pairing[0]].Name(), strategies[pairing[1]].Name()}]++
+	}
+	for played := 0; c.Completed < len(pairings) && (limit < 0 || played < limit); played++ {
+		i, j := pairings[c.Completed][0], pairings[c.Completed][1]
+		stratA, stratB := strategies[i], strategies[j]
+		key := [2]string{stratA.Name(), stratB.Name()}
+		seedA := pairingSeed(c.Config.Seed, stratA.Name(), stratB.Name(), occurrences[key])
+		seedB := pairingSeed(c.Config.Seed, stratB.Name(), stratA.Name(), occurrences[key])
+		if seedA == seedB {
+			seedB++ // Uma estratégia contra si mesma: os dois lados precisam de geradores diferentes
+		}
+		occurrences[key]++
+
+		// Cria instâncias frescas das estratégias para evitar estado compartilhado; aprendizes
+		// persistentes reaproveitam a sua (no jogo contra si mesmo, só do lado A)
+		strategyA := c.instance(strategies, i)
+		strategyB := freshInstance(stratB)
+		if i != j {
+			strategyB = c.instance(strategies, j)
+		}
+
+		// Executa o jogo entre strategyA e strategyB
+		game := NewGame(strategyA, strategyB, c.Config.Rounds)
+		game.SetPayoff(c.Config.payoff())
+		if c.Config.Seed != 0 {
+			game.seedSides(seedA, seedB)
+		}
+		if c.Config.Noise > 0 {
+			var source *rand.Rand
+			if c.Config.Seed != 0 {
+				source = rand.New(rand.NewSource(seedA ^ seedB))
+			}
+			game.SetNoise(c.Config.Noise, source)
+		}
+		game.SetHistoryWindow(c.Config.HistoryWindow)
+		informReputation(strategyA, reputation, stratB.Name())
+		informReputation(strategyB, reputation, stratA.Name())
+		for round := 0; round < c.Config.Rounds; round++ {
+			if game.PlayRound(round) != nil {
+				break
+			}
+		}
+		// Uma estratégia que entrou em pânico perde o jogo, mas o torneio continua
+		if game.forfeited[0] {
+			c.Forfeits[stratA.Name()]++
+		}
+		if game.forfeited[1] {
+			c.Forfeits[stratB.Name()]++
+		}
+		reputation.Record(stratA.Name(), game.movesA)
+		reputation.Record(stratB.Name(), game.movesB)
+		for round := range game.movesA {
+			c.RoundMoves[round] += 2
+			c.RoundCooperations[round] += countMoves([]Choice{game.movesA[round], game.movesB[round]}, Cooperate)
+		}
+		c.Deviations[stratA.Name()] += tftDeviations(game.movesA, game.movesB)
+		c.Deviations[stratB.Name()] += tftDeviations(game.movesB, game.movesA)
+		// Trair na mesma rodada que o oponente também conta como trair primeiro
+		firstA, firstB := firstDefection(game.movesA), firstDefection(game.movesB)
+		if firstA >= 0 && (firstB < 0 || firstA <= firstB) {
+			c.FirstDefectors[i][j]++
+		}
+		if firstB >= 0 && (firstA < 0 || firstB <= firstA) {
+			c.FirstDefectors[j][i]++
+		}
+
+		// Adiciona os pontos ao total de cada estratégia
+		c.TotalScores[stratA.Name()] += game.scores[0]
+		c.TotalScores[stratB.Name()] += game.scores[1]
+		c.Matrix[i][j] += game.scores[0]
+		c.Matrix[j][i] += game.scores[1]
+		if learner, ok := strategyA.(Learner); ok {
+			learner.Learn(game.scores[0], c.Config.Rounds)
+		}
+		if learner, ok := strategyB.(Learner); ok {
+			learner.Learn(game.scores[1], c.Config.Rounds)
+		}
+		c.Completed++
+	}
+	return c.Completed == len(pairings)
+}
+
+// instance retorna a instância de strategies[i] para o próximo jogo: a persistente, se a
+// estratégia for um Learner e o torneio permitir aprendizes persistentes, ou uma nova
+func (c *TournamentCheckpoint) instance(strategies []Strategy, i int) Strategy {
+	if _, ok := strategies[i].(Learner); !ok || !c.Config.PersistentLearners {
+		return freshInstance(strategies[i])
+	}
+	if c.learners == nil {
+		c.learners = make(map[int]Strategy)
+	}
+	if c.learners[i] == nil {
+		c.learners[i] = freshInstance(strategies[i])
+	}
+	return c.learners[i]
+}
+
+// neverDefectedFirst informa se strategies[i] nunca traiu primeiro (nem junto com o oponente) em
+// nenhum dos confrontos já disputados
+func (c *TournamentCheckpoint) neverDefectedFirst(i int) bool {
+	for _, games := range c.FirstDefectors[i] {
+		if games > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// results converte os totais acumulados no checkpoint na classificação do torneio
+func (c *TournamentCheckpoint) results(strategies []Strategy) ([]Result, [][]int) {
+	cfg, totalScores, matrix, reputation := c.Config, c.TotalScores, c.Matrix, c.reputation()
+
+	// Converte os resultados para uma lista de Result
+	results := make([]Result, 0, len(strategies))
+	for name, score := range totalScores {
+		coopRate, _ := reputation.Score(name)
+		deviation := ratio(c.Deviations[name], c.Moves[name])
+		games := 0
+		if cfg.Rounds > 0 {
+			games = c.Moves[name] / cfg.Rounds
+		}
+		results = append(results, Result{name: name, score: score, games: games, coopRate: coopRate, deviation: deviation})
+	}
+
+	// Índice de cada estratégia na matriz, para o desempate por confronto direto
+	index := make(map[string]int, len(strategies))
+	for i, s := range strategies {
+		index[s.Name()] = i
+	}
+	for i := range results {
+		results[i].nice = c.neverDefectedFirst(index[results[i].name])
+		results[i].forfeits = c.Forfeits[results[i].name]
+		results[i].stateless = isStateless(strategies[index[results[i].name]])
+	}
+
+	// Ordena os resultados por pontuação (maior para menor), desempatando pelo critério escolhido
+	// e, por fim, pelo nome, para que a ordem seja sempre determinística
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		switch cfg.TieBreak {
+		case TieBreakCooperation:
+			if a.coopRate != b.coopRate {
+				return a.coopRate > b.coopRate
+			}
+		case TieBreakHeadToHead:
+			ia, ib := index[a.name], index[b.name]
+			if matrix[ia][ib] != matrix[ib][ia] {
+				return matrix[ia][ib] > matrix[ib][ia]
+			}
+		}
+		return a.name < b.name
+	})
+	for i := range results {
+		results[i].rank = i + 1
+	}
+
+	return results, matrix
+}
+
+func main() {
+	// Log de depuração das partidas do modo normal, ativado pela linha de comando
+	logLevelFlag := flag.String("log-level", "none", "detalhamento do log de depuração: none, moves ou state")
+	logFile := flag.String("log-file", "spieltheorie-debug.jsonl", "arquivo do log de depuração (JSON, uma rodada por linha)")
+	historyFile := flag.String("history-file", "spieltheorie-history.jsonl", "histórico das partidas do modo normal (JSON, uma partida por linha)")
+	tournamentDir := flag.String("tournament-dir", "spieltheorie-torneios", "pasta onde cada torneio terminado é gravado (JSON, um arquivo por torneio)")
+	flag.Parse()
+	logLevel, err := parseLogLevel(*logLevelFlag)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	// Seed para escolhas aleatórias
+	seedRNG(time.Now().UnixNano())
+
+	// Cria a aplicação Fyne
+	myApp := app.NewWithID("io.github.rodvanhoz.spieltheorie")
+	applyTheme(myApp, myApp.Preferences().Bool(themePreferenceKey))
+	setLanguage(myApp, myApp.Preferences().StringWithFallback(languagePreferenceKey, defaultLanguage))
+	setDisplayMode(myApp, DisplayMode(myApp.Preferences().IntWithFallback(displayModePreferenceKey, int(DisplayEmoji))))
+	myWindow := myApp.NewWindow(tr("app_title"))
+	myWindow.Resize(fyne.NewSize(800, 600))
+
+	// Lista de estratégias disponíveis
+	strategies := newStrategies()
+
+	// Lista de nomes das estratégias para os dropdowns
+	strategyNames := make([]string, len(strategies))
+	for i, s := range strategies {
+		strategyNames[i] = s.Name()
+	}
+
+	// Configurações compartilhadas pelas telas, com suporte a desfazer e restaurar padrões
+	config := newConfigStore()
+
+	showNormalMode := func() {
+		// Tela do modo normal; além das estratégias registradas, é possível escolher a composta
+		phasedOption := tr("phased_option")
+		memoryOneOption := tr("memory_one_option")
+		curveOption := tr("curve_option")
+		mixedOption := tr("mixed_option")
+		neuralOption := tr("neural_option")
+		selectOptions := append(append([]string{}, strategyNames...), phasedOption, memoryOneOption, curveOption, mixedOption, neuralOption)
+		strategyASelect := widget.NewSelect(selectOptions, func(value string) {})
+		strategyBSelect := widget.NewSelect(selectOptions, func(value string) {})
+
+		// Filtro por categoria: os dropdowns passam a listar só as estratégias da categoria escolhida
+		categorySelect := widget.NewSelect(append([]string{tr("category_all")}, categoryLabels()...), func(string) {})
+		categorySelect.OnChanged = func(string) {
+			names := strategyNames
+			if index := categorySelect.SelectedIndex(); index > 0 {
+				names = strategiesInCategory(strategies, StrategyCategory(index-1))
+			}
+			options := append(append([]string{}, names...), phasedOption, memoryOneOption, curveOption, mixedOption, neuralOption)
+			strategyASelect.Options, strategyBSelect.Options = options, options
+			strategyASelect.Refresh()
+			strategyBSelect.Refresh()
+		}
+		categorySelect.SetSelectedIndex(0)
+
+		// Descrição da estratégia escolhida em cada dropdown, para ajudar quem está começando
+		descriptionA := widget.NewLabel("")
+		descriptionA.Wrapping = fyne.TextWrapWord
+		descriptionB := widget.NewLabel("")
+		descriptionB.Wrapping = fyne.TextWrapWord
+		describeOption := func(option string) string {
+			switch option {
+			case "":
+				return ""
+			case phasedOption:
+				return tr("desc_phased")
+			case memoryOneOption:
+				return tr("desc_memory_one")
+			case curveOption:
+				return tr("desc_curve")
+			case mixedOption:
+				return tr("desc_mixed")
+			case neuralOption:
+				return tr("desc_neural")
+			}
+			if s := newStrategy(option); s != nil {
+				return strategyDescription(s)
+			}
+			return ""
+		}
+
+		// Estratégia composta: joga a primeira até a rodada de troca, depois a segunda
+		phasedFirstSelect := widget.NewSelect(strategyNames, func(value string) {})
+		phasedFirstSelect.SetSelected(AlwaysCooperate{}.Name())
+		phasedSecondSelect := widget.NewSelect(strategyNames, func(value string) {})
+		phasedSecondSelect.SetSelected(TitForTat{}.Name())
+		phasedSwitchEntry := widget.NewEntry()
+		phasedSwitchEntry.SetText("10")
+
+		// Parâmetros de Tideman & Chieruzzi, exibidos só quando ela está selecionada
+		tidemanName := TidemanChieruzzi{}.Name()
+		tidemanWindowEntry := widget.NewEntry()
+		tidemanWindowEntry.SetText("5")
+		tidemanThresholdEntry := widget.NewEntry()
+		tidemanThresholdEntry.SetText("0.5")
+		tidemanParams := container.NewVBox(
+			widget.NewLabel(tr("tideman_params")),
+			container.NewGridWithColumns(2, tidemanWindowEntry, tidemanThresholdEntry),
+		)
+		tidemanParams.Hide()
+
+		// Alvo do Self Regulator, exibido só quando ele está selecionado
+		selfRegulatorName := (&SelfRegulator{}).Name()
+		selfRegulatorEntry := widget.NewEntry()
+		selfRegulatorEntry.SetText("0.6")
+		selfRegulatorParams := container.NewVBox(widget.NewLabel(tr("self_regulator_params")), selfRegulatorEntry)
+		selfRegulatorParams.Hide()
+
+		// Proporção alvo do Ratio Targeter, exibida só quando ele está selecionado
+		ratioTargeterName := (&RatioTargeter{}).Name()
+		ratioTargeterEntry := widget.NewEntry()
+		ratioTargeterEntry.SetText("1.5")
+		ratioTargeterParams := container.NewVBox(widget.NewLabel(tr("ratio_targeter_params")), ratioTargeterEntry)
+		ratioTargeterParams.Hide()
+
+		// Estratégia de memória um no formato compartilhável "mo:pCC/pCD/pDC/pDD@inicial"
+		memoryOneEntry := widget.NewEntry()
+		memoryOneEntry.SetText(formatMemoryOne(NewMemoryOne(0.9, 0.1, 0.9, 0.1, 0.99)))
+		memoryOneCopyButton := widget.NewButton(tr("copy"), func() {
+			// Copia a versão normalizada, se o texto for válido
+			text := memoryOneEntry.Text
+			if s, err := parseMemoryOne(text); err == nil {
+				text = formatMemoryOne(s)
+			}
+			myWindow.Clipboard().SetContent(text)
+		})
+		memoryOneParams := container.NewVBox(
+			widget.NewLabel(tr("memory_one_params")),
+			container.NewBorder(nil, nil, nil, memoryOneCopyButton, memoryOneEntry),
+		)
+		memoryOneParams.Hide()
+
+		// Curva de probabilidade de traição, editada como uma lista de pontos rodada:probabilidade
+		curveEntry := widget.NewEntry()
+		curveEntry.SetText(formatCurvePoints([]CurvePoint{{Round: 0, PDefect: 0}, {Round: 100, PDefect: 0.2}, {Round: 200, PDefect: 1}}))
+		curveParams := container.NewVBox(widget.NewLabel(tr("curve_params")), curveEntry)
+		curveParams.Hide()
+
+		// Mistura probabilística: a cada rodada, a primeira com probabilidade p, senão a segunda
+		mixedFirstSelect := widget.NewSelect(strategyNames, func(value string) {})
+		mixedFirstSelect.SetSelected(TitForTat{}.Name())
+		mixedSecondSelect := widget.NewSelect(strategyNames, func(value string) {})
+		mixedSecondSelect.SetSelected(AlwaysDefect{}.Name())
+		mixedLabel := widget.NewLabel("")
+		mixedSlider := widget.NewSlider(0, 1)
+		mixedSlider.Step = 0.05
+		mixedSlider.OnChanged = func(p float64) {
+			mixedLabel.SetText(fmt.Sprintf(tr("mixed_probability"), p*100))
+		}
+		mixedSlider.SetValue(0.8)
+		mixedParams := container.NewVBox(
+			widget.NewLabel(tr("mixed_params")),
+			container.NewGridWithColumns(2, mixedFirstSelect, mixedSecondSelect),
+			mixedLabel,
+			mixedSlider,
+		)
+		mixedParams.Hide()
+
+		// Rede neural com pesos carregados de um arquivo JSON (até lá, os pesos que imitam Tit-for-Tat)
+		neuralWeights := titForTatWeights
+		neuralFileLabel := widget.NewLabel(tr("neural_default"))
+		neuralLoadButton := widget.NewButton(tr("neural_load"), func() {
+			dialog.ShowFileOpen(func(reader fyne.URIReadCloser, err error) {
+				if err != nil || reader == nil {
+					return
+				}
+				defer reader.Close()
+				data, err := io.ReadAll(reader)
+				if err != nil {
+					dialog.ShowError(err, myWindow)
+					return
+				}
+				weights, err := parseNeuralWeights(data)
+				if err != nil {
+					dialog.ShowError(err, myWindow)
+					return
+				}
+				neuralWeights = weights
+				neuralFileLabel.SetText(reader.URI().Name())
+			}, myWindow)
+		})
+		neuralParams := container.NewVBox(
+			widget.NewLabel(tr("neural_params")),
+			container.NewBorder(nil, nil, neuralLoadButton, nil, neuralFileLabel),
+		)
+		neuralParams.Hide()
+
+		// resolveStrategy cria uma instância nova da opção escolhida em um dos dropdowns
+		resolveStrategy := func(option string) (Strategy, error) {
+			if option == tidemanName {
+				window, err := strconv.Atoi(tidemanWindowEntry.Text)
+				if err != nil || window <= 0 {
+					return nil, errors.New(tr("err_tideman_window"))
+				}
+				threshold, err := strconv.ParseFloat(tidemanThresholdEntry.Text, 64)
+				if err != nil || threshold < 0 || threshold > 1 {
+					return nil, errors.New(tr("err_tideman_threshold"))
+				}
+				return NewTidemanChieruzzi(window, threshold), nil
+			}
+			if option == selfRegulatorName {
+				target, err := strconv.ParseFloat(selfRegulatorEntry.Text, 64)
+				if err != nil || target < 0 || target > 1 {
+					return nil, errors.New(tr("err_self_regulator_target"))
+				}
+				return NewSelfRegulator(target), nil
+			}
+			if option == ratioTargeterName {
+				target, err := strconv.ParseFloat(ratioTargeterEntry.Text, 64)
+				if err != nil || target <= 0 {
+					return nil, errors.New(tr("err_ratio_targeter"))
+				}
+				return NewRatioTargeter(target), nil
+			}
+			if option == memoryOneOption {
+				return parseMemoryOne(memoryOneEntry.Text)
+			}
+			if option == mixedOption {
+				first := newStrategy(mixedFirstSelect.Selected)
+				second := newStrategy(mixedSecondSelect.Selected)
+				if first == nil || second == nil {
+					return nil, errors.New(tr("err_mixed_parts"))
+				}
+				return NewMixedStrategy(first, second, mixedSlider.Value), nil
+			}
+			if option == neuralOption {
+				return NewNeuralStrategy(neuralWeights), nil
+			}
+			if option == curveOption {
+				points, err := parseCurvePoints(curveEntry.Text)
+				if err != nil {
+					return nil, err
+				}
+				return NewCurveStrategy(points), nil
+			}
+			if option != phasedOption {
+				if s := newStrategy(option); s != nil {
+					return s, nil
+				}
+				return nil, errors.New(tr("err_choose_both"))
+			}
+			switchRound, err := strconv.Atoi(phasedSwitchEntry.Text)
+			if err != nil || switchRound < 0 {
+				return nil, errors.New(tr("err_phased_switch"))
+			}
+			first := newStrategy(phasedFirstSelect.Selected)
+			second := newStrategy(phasedSecondSelect.Selected)
+			if first == nil || second == nil {
+				return nil, errors.New(tr("err_phased_parts"))
+			}
+			return NewPhased(first, second, switchRound), nil
+		}
+
+		roundsEntry := newConfigEntry()
+		roundsEntry.SetPlaceHolder(tr("rounds_placeholder"))
+
+		// Matriz de pontuação da partida e da análise exata
+		payoffEntry := newConfigEntry()
+
+		// Semente da partida (0 = uma nova a cada partida)
+		seedEntry := newConfigEntry()
+
+		// Sincroniza os widgets com a configuração; syncing evita registrar a própria sincronização como edição
+		syncing := false
+		applyConfig := func() {
+			syncing = true
+			strategyASelect.SetSelected(config.current.StrategyA)
+			strategyBSelect.SetSelected(config.current.StrategyB)
+			roundsEntry.SetText(strconv.Itoa(config.current.Rounds))
+			payoffEntry.SetText(formatPayoff(config.current.Payoff))
+			seedEntry.SetText(strconv.FormatInt(config.current.Seed, 10))
+			syncing = false
+		}
+		// Grava os valores válidos dos widgets na configuração; os inválidos mantêm o valor anterior
+		storeConfig := func() {
+			if syncing {
+				return
+			}
+			cfg := config.current
+			cfg.StrategyA = strategyASelect.Selected
+			cfg.StrategyB = strategyBSelect.Selected
+			if rounds, err := strconv.Atoi(roundsEntry.Text); err == nil && rounds > 0 {
+				cfg.Rounds = rounds
+			}
+			if payoff, err := parsePayoff(payoffEntry.Text); err == nil {
+				cfg.Payoff = payoff
+			}
+			if seed, err := strconv.ParseInt(seedEntry.Text, 10, 64); err == nil {
+				cfg.Seed = seed
+			}
+			config.Set(cfg)
+		}
+		onStrategyChanged := func(string) {
+			descriptionA.SetText(describeOption(strategyASelect.Selected))
+			descriptionB.SetText(describeOption(strategyBSelect.Selected))
+			if strategyASelect.Selected == tidemanName || strategyBSelect.Selected == tidemanName {
+				tidemanParams.Show()
+			} else {
+				tidemanParams.Hide()
+			}
+			if strategyASelect.Selected == selfRegulatorName || strategyBSelect.Selected == selfRegulatorName {
+				selfRegulatorParams.Show()
+			} else {
+				selfRegulatorParams.Hide()
+			}
+			if strategyASelect.Selected == ratioTargeterName || strategyBSelect.Selected == ratioTargeterName {
+				ratioTargeterParams.Show()
+			} else {
+				ratioTargeterParams.Hide()
+			}
+			if strategyASelect.Selected == memoryOneOption || strategyBSelect.Selected == memoryOneOption {
+				memoryOneParams.Show()
+			} else {
+				memoryOneParams.Hide()
+			}
+			if strategyASelect.Selected == curveOption || strategyBSelect.Selected == curveOption {
+				curveParams.Show()
+			} else {
+				curveParams.Hide()
+			}
+			if strategyASelect.Selected == mixedOption || strategyBSelect.Selected == mixedOption {
+				mixedParams.Show()
+			} else {
+				mixedParams.Hide()
+			}
+			if strategyASelect.Selected == neuralOption || strategyBSelect.Selected == neuralOption {
+				neuralParams.Show()
+			} else {
+				neuralParams.Hide()
+			}
+			storeConfig()
+		}
+		strategyASelect.OnChanged = onStrategyChanged
+		strategyBSelect.OnChanged = onStrategyChanged
+		roundsEntry.onCommit = storeConfig
+		payoffEntry.onCommit = storeConfig
+		seedEntry.onCommit = storeConfig
+		applyConfig()
+
+		resetButton := widget.NewButton(tr("reset_defaults"), func() {
+			config.Reset()
+			applyConfig()
+		})
+		undoButton := widget.NewButton(tr("undo"), func() {
+			if config.Undo() {
+				applyConfig()
+			}
+		})
+
+		// Barra de progresso para o progresso das rodadas
+		progressBar := widget.NewProgressBar()
+		progressBar.Min = 0
+		progressBar.Max = 1
+
+		// Pontuação média por rodada de cada estratégia, atualizada ao vivo durante o jogo
+		averageLabelA, averageLabelB := widget.NewLabel(""), widget.NewLabel("")
+		averagesRow := container.NewGridWithColumns(2, averageLabelA, averageLabelB)
+		averagesRow.Hide()
+		averageCheck := widget.NewCheck(tr("live_average"), func(on bool) {
+			if on {
+				averagesRow.Show()
+			} else {
+				averagesRow.Hide()
+			}
+		})
+
+		// Tabela para exibir o histórico das rodadas, com a matriz do jogo para explicar cada rodada
+		roundsHistory := make([]roundData, 0)
+		historyPayoff := defaultPayoff
+
+		// Cria a tabela
+		table := widget.NewTable(
+			func() (int, int) {
+				return len(roundsHistory), 7 // 7 colunas: Rodada, Move A, Move B, Score A, Score B, Pontos A, Pontos B
+			},
+			func() fyne.CanvasObject {
+				// O fundo colore as jogadas no modo só cor
+				return container.NewStack(canvas.NewRectangle(color.Transparent), newTooltipLabel())
+			},
+			func(cell widget.TableCellID, o fyne.CanvasObject) {
+				stack := o.(*fyne.Container)
+				background, label := stack.Objects[0].(*canvas.Rectangle), stack.Objects[1].(*tooltipLabel)
+				data := roundsHistory[cell.Row]
+				background.FillColor = color.Transparent
+				// Passar o mouse sobre uma jogada explica o resultado da rodada
+				if cell.Col == 1 || cell.Col == 2 {
+					label.SetTooltip(roundTooltip(data, historyPayoff))
+				} else {
+					label.SetTooltip("")
+				}
+				switch cell.Col {
+				case 0:
+					label.SetText(fmt.Sprintf("%d", data.round))
+				case 1:
+					label.SetText(renderMove(data.moveA, displayMode))
+					background.FillColor = moveColor(data.moveA, displayMode)
+				case 2:
+					label.SetText(renderMove(data.moveB, displayMode))
+					background.FillColor = moveColor(data.moveB, displayMode)
+				case 3:
+					label.SetText(fmt.Sprintf("%d", data.scoreA))
+				case 4:
+					label.SetText(fmt.Sprintf("%d", data.scoreB))
+				case 5:
+					label.SetText(fmt.Sprintf("%d", data.pointsA))
+				case 6:
+					label.SetText(fmt.Sprintf("%d", data.pointsB))
+				}
+				background.Refresh()
+			},
+		)
+		// Define os cabeçalhos da tabela
+		table.CreateHeader = func() fyne.CanvasObject {
+			return widget.NewLabel("")
+		}
+		table.UpdateHeader = func(cell widget.TableCellID, o fyne.CanvasObject) {
+			label := o.(*widget.Label)
+			switch cell.Col {
+			case 0:
+				label.SetText(tr("col_round"))
+			case 1:
+				label.SetText(tr("col_move_a"))
+			case 2:
+				label.SetText(tr("col_move_b"))
+			case 3:
+				label.SetText(tr("col_score_a"))
+			case 4:
+				label.SetText(tr("col_score_b"))
+			case 5:
+				label.SetText(tr("col_points_a"))
+			case 6:
+				label.SetText(tr("col_points_b"))
+			}
+		}
+		// Define larguras das colunas
+		table.SetColumnWidth(0, 80)
+		table.SetColumnWidth(1, 100)
+		table.SetColumnWidth(2, 100)
+		table.SetColumnWidth(3, 100)
+		table.SetColumnWidth(4, 100)
+		table.SetColumnWidth(5, 100)
+		table.SetColumnWidth(6, 100)
+
+		// Define um tamanho mínimo para a tabela (ex.: 10 linhas visíveis)
+		table.MinSize()
+		tableContainer := container.NewVScroll(table)
+		tableContainer.SetMinSize(fyne.NewSize(500, 300)) // Ajusta para mostrar ~10 linhas
+
+		// Resumo em texto da partida, uma frase por rodada, navegável como lista
+		var recap []string
+		recapList := widget.NewList(
+			func() int { return len(recap) },
+			func() fyne.CanvasObject { return widget.NewLabel("") },
+			func(id widget.ListItemID, o fyne.CanvasObject) { o.(*widget.Label).SetText(recap[id]) },
+		)
+		recapContainer := container.NewVScroll(recapList)
+		recapContainer.SetMinSize(fyne.NewSize(500, 200))
+
+		// Busca no histórico: rola a tabela até a primeira rodada que satisfaz o critério
+		searchLabel := widget.NewLabel("")
+		searchButton := func(label string, pred func(roundData) bool) *widget.Button {
+			return widget.NewButton(label, func() {
+				row := findFirst(roundsHistory, pred)
+				if row < 0 {
+					searchLabel.SetText(tr("search_not_found"))
+					return
+				}
+				searchLabel.SetText(fmt.Sprintf(tr("search_found"), roundsHistory[row].round))
+				table.ScrollTo(widget.TableCellID{Row: row, Col: 0})
+				table.Select(widget.TableCellID{Row: row, Col: 0})
+			})
+		}
+		searchButtons := container.NewHBox(
+			searchButton(tr("search_first_defect_a"), func(r roundData) bool { return r.moveA == Defect }),
+			searchButton(tr("search_first_defect_b"), func(r roundData) bool { return r.moveB == Defect }),
+			searchButton(tr("search_first_dd"), func(r roundData) bool { return r.moveA == Defect && r.moveB == Defect }),
+			searchLabel,
+		)
+
+		// Label para o resultado final
+		resultLabel := widget.NewLabel("")
+		resultLabel.Wrapping = fyne.TextWrapWord
+
+		// Correlação das jogadas dos dois jogadores em uma janela deslizante, exibida ao fim do jogo
+		correlationChart := NewLineChart()
+		correlationChart.SetRange(-1, 1, "-1", "1")
+		correlationSection := container.NewVBox(
+			widget.NewLabel(fmt.Sprintf(tr("correlation_label"), correlationWindow)),
+			correlationChart,
+		)
+		correlationSection.Hide()
+
+		// Cartões de resumo de cada estratégia, lado a lado, exibidos ao fim do jogo
+		cardsGrid := container.NewGridWithColumns(2)
+		showCards := func(cards [2]StrategyCard) {
+			cardsGrid.RemoveAll()
+			for _, card := range cards {
+				stats := widget.NewLabel(fmt.Sprintf(tr("card_stats"),
+					card.score, card.coopRate*100, card.exploited, card.exploiter))
+				description := widget.NewLabel(card.description)
+				description.Wrapping = fyne.TextWrapWord
+				cardsGrid.Add(widget.NewCard(card.name, "", container.NewVBox(description, stats)))
+			}
+		}
+
+		// Exporta o gráfico de pontuação acumulada da última partida como GIF animado
+		exportGIFButton := widget.NewButton(tr("export_gif"), func() {
+			if len(roundsHistory) == 0 {
+				resultLabel.SetText(tr("err_export_no_match"))
+				return
+			}
+			scoresA := make([]int, len(roundsHistory))
+			scoresB := make([]int, len(roundsHistory))
+			for i, data := range roundsHistory {
+				scoresA[i], scoresB[i] = data.scoreA, data.scoreB
+			}
+			dialog.ShowFileSave(func(writer fyne.URIWriteCloser, err error) {
+				if err != nil || writer == nil {
+					return
+				}
+				defer writer.Close()
+				if err := writeScoreChartGIF(writer, scoresA, scoresB); err != nil {
+					dialog.ShowError(err, myWindow)
+				}
+			}, myWindow)
+		})
+
+		// Análise exata pela cadeia de Markov, possível quando as duas estratégias são de memória um
+		exactButton := widget.NewButton(tr("exact_analysis"), func() {
+			payoff, err := parsePayoff(payoffEntry.Text)
+			if err != nil {
+				resultLabel.SetText(err.Error())
+				return
+			}
+			strategyA, err := resolveStrategy(strategyASelect.Selected)
+			if err != nil {
+				resultLabel.SetText(err.Error())
+				return
+			}
+			strategyB, err := resolveStrategy(strategyBSelect.Selected)
+			if err != nil {
+				resultLabel.SetText(err.Error())
+				return
+			}
+			memoryA, okA := strategyA.(MemoryOneStrategy)
+			memoryB, okB := strategyB.(MemoryOneStrategy)
+			if !okA || !okB {
+				resultLabel.SetText(tr("err_exact_memory_one"))
+				return
+			}
+			scoreA, scoreB, coopRate := stationaryOutcome(memoryA.AsMemoryOne(), memoryB.AsMemoryOne(), payoff)
+			resultLabel.SetText(fmt.Sprintf(tr("exact_result"),
+				strategyA.Name(), scoreA, strategyB.Name(), scoreB, coopRate*100))
+		})
+
+		// Joga também com os papéis trocados e mostra a média das duas ordens
+		swapCheck := widget.NewCheck(tr("swap_roles"), nil)
+
+		// Bônus por sequências de cooperação mútua (0 = desativado)
+		coopBonusEntry := widget.NewEntry()
+		coopBonusEntry.SetText("0")
+
+		// Penalidade crescente por sequências de traição mútua (0 = desativada)
+		escalationEntry := widget.NewEntry()
+		escalationEntry.SetText("0")
+
+		// Janela de histórico: quantas rodadas anteriores as estratégias enxergam (0 = todas)
+		windowEntry := widget.NewEntry()
+		windowEntry.SetText("0")
+
+		// Pontuação inicial de cada estratégia, para simular uma vantagem no começo do jogo
+		startScoreEntryA := widget.NewEntry()
+		startScoreEntryA.SetText("0")
+		startScoreEntryB := widget.NewEntry()
+		startScoreEntryB.SetText("0")
+
+		// Pontuação alvo: o jogo termina quando alguém a atinge, com as rodadas como limite (0 = desativada)
+		targetEntry := widget.NewEntry()
+		targetEntry.SetText("0")
+
+		// Semente da partida sorteada pela "estratégia do dia" (0 nas partidas escolhidas pelo usuário)
+		var matchupSeed int64
+		startButton := widget.NewButton(tr("start_game"), func() {
+			// Toda partida tem uma semente, registrada no histórico para que possa ser rejogada
+			seed, fromMatchup := matchupSeed, matchupSeed != 0
+			matchupSeed = 0
+			if !fromMatchup {
+				chosen, err := strconv.ParseInt(seedEntry.Text, 10, 64)
+				if err != nil {
+					resultLabel.SetText(tr("err_seed"))
+					return
+				}
+				seed = chosen
+				if seed == 0 {
+					seed = time.Now().UnixNano()
+				}
+			}
 			rounds, err := strconv.Atoi(roundsEntry.Text)
 			if err != nil || rounds <= 0 {
-				resultLabel.SetText("Por favor, insira um número de rodadas válido!")
+				resultLabel.SetText(tr("err_invalid_rounds"))
+				return
+			}
+			coopBonus, err := strconv.Atoi(coopBonusEntry.Text)
+			if err != nil || coopBonus < 0 {
+				resultLabel.SetText(tr("err_coop_bonus"))
+				return
+			}
+			escalation, err := strconv.Atoi(escalationEntry.Text)
+			if err != nil || escalation < 0 {
+				resultLabel.SetText(tr("err_escalation"))
+				return
+			}
+			window, err := strconv.Atoi(windowEntry.Text)
+			if err != nil || window < 0 {
+				resultLabel.SetText(tr("err_history_window"))
+				return
+			}
+			target, err := strconv.Atoi(targetEntry.Text)
+			if err != nil || target < 0 {
+				resultLabel.SetText(tr("err_target_score"))
+				return
+			}
+			startScoreA, errA := strconv.Atoi(startScoreEntryA.Text)
+			startScoreB, errB := strconv.Atoi(startScoreEntryB.Text)
+			if errA != nil || errB != nil {
+				resultLabel.SetText(tr("err_start_score"))
+				return
+			}
+			payoff, err := parsePayoff(payoffEntry.Text)
+			if err != nil {
+				resultLabel.SetText(err.Error())
 				return
 			}
 
 			// Encontra as estratégias selecionadas
-			var strategyA, strategyB Strategy
-			for _, s := range strategies {
-				if s.Name() == strategyASelect.Selected {
-					switch s.Name() {
-					case "Shubik":
-						strategyA = &Shubik{}
-					case "Friedman":
-						strategyA = &Friedman{}
-					case "Downing":
-						strategyA = &Downing{}
-					default:
-						strategyA = s
-					}
-				}
-				if s.Name() == strategyBSelect.Selected {
-					switch s.Name() {
-					case "Shubik":
-						strategyB = &Shubik{}
-					case "Friedman":
-						strategyB = &Friedman{}
-					case "Downing":
-						strategyB = &Downing{}
-					default:
-						strategyB = s
-					}
-				}
+			strategyA, err := resolveStrategy(strategyASelect.Selected)
+			if err != nil {
+				resultLabel.SetText(err.Error())
+				return
+			}
+			strategyB, err := resolveStrategy(strategyBSelect.Selected)
+			if err != nil {
+				resultLabel.SetText(err.Error())
+				return
 			}
 
 			// Atualiza os cabeçalhos da tabela com os nomes das estratégias
@@ -585,29 +3795,48 @@ func main() {
 			// Limpa o histórico
 			roundsHistory = roundsHistory[:0]
 			table.Refresh()
+			recap = nil
+			recapList.Refresh()
 
 			// Configura a barra de progresso
 			progressBar.Max = float64(rounds)
 			progressBar.Value = 0
 			progressBar.Refresh()
+			averageLabelA.SetText("")
+			averageLabelB.SetText("")
 
 			// Executa o jogo
 			game := NewGame(strategyA, strategyB, rounds)
-			for i := 0; i < rounds; i++ {
-				game.PlayRound(i)
-
-				// Adiciona a rodada ao histórico
-				roundsHistory = append(roundsHistory, roundData{
-					round:  i + 1,
-					moveA:  moveToSymbol(game.movesA[i]),
-					moveB:  moveToSymbol(game.movesB[i]),
-					scoreA: game.scores[0],
-					scoreB: game.scores[1],
-				})
+			game.SetPayoff(payoff)
+			game.SetCooperationBonus(coopBonus)
+			game.SetDefectionEscalation(escalation)
+			game.SetStartingScores(startScoreA, startScoreB)
+			historyPayoff = game.payoff
+			game.SetHistoryWindow(window)
+			game.SeedStrategies(seed)
+			if logLevel != LogNone {
+				f, err := os.OpenFile(*logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+				if err != nil {
+					// Sem o log a partida ainda pode ser jogada normalmente
+					fmt.Fprintln(os.Stderr, err)
+				} else {
+					defer f.Close()
+					game.SetLog(f, logLevel)
+				}
+			}
+			// Uma rodada por vez, com a mesma regra de parada de RunUntilScore
+			for i := 0; ; i++ {
+				if played, err := game.playTowardScore(target, rounds); !played || err != nil {
+					break
+				}
 
-				// Atualiza a tabela e a barra de progresso
+				roundsHistory = appendRound(roundsHistory, game)
+
+				// Atualiza a tabela, a barra de progresso e as médias por rodada
 				progressBar.SetValue(float64(i + 1))
 				table.Refresh()
+				averageLabelA.SetText(fmt.Sprintf(tr("live_average_line"), strategyA.Name(), perRoundScore(game.scores[0]-game.handicap[0], i+1, 1)))
+				averageLabelB.SetText(fmt.Sprintf(tr("live_average_line"), strategyB.Name(), perRoundScore(game.scores[1]-game.handicap[1], i+1, 1)))
 
 				// Rola para a última linha
 				if len(roundsHistory) > 0 {
@@ -617,96 +3846,1055 @@ func main() {
 				time.Sleep(100 * time.Millisecond) // Pausa para visualização
 			}
 
+			// Resumo em texto para leitores de tela
+			recap = matchRecap(strategyA.Name(), strategyB.Name(), roundsHistory)
+			recapList.Refresh()
+
 			// Resultado final
-			var output strings.Builder
-			output.WriteString("Resultado Final:\n")
-			output.WriteString(fmt.Sprintf("%s: %d pontos\n", strategyA.Name(), game.scores[0]))
-			output.WriteString(fmt.Sprintf("%s: %d pontos\n", strategyB.Name(), game.scores[1]))
-			if game.scores[0] > game.scores[1] {
-				output.WriteString(fmt.Sprintf("Vencedor: %s!\n", strategyA.Name()))
-			} else if game.scores[1] > game.scores[0] {
-				output.WriteString(fmt.Sprintf("Vencedor: %s!\n", strategyB.Name()))
-			} else {
-				output.WriteString("Empate!\n")
+			outcome := formatOutcome(strategyA.Name(), strategyB.Name(), game.scores[0], game.scores[1])
+			if swapCheck.Checked {
+				averageA, averageB := swappedAverage(game, playSwapped(game))
+				outcome += fmt.Sprintf(tr("swapped_average")+"\n", strategyA.Name(), averageA, strategyB.Name(), averageB)
+			}
+			if target > 0 {
+				race := game.scoreRace(target)
+				switch {
+				case !race.reached:
+					outcome += fmt.Sprintf(tr("race_cap")+"\n", target, race.rounds)
+				case race.winner < 0:
+					outcome += fmt.Sprintf(tr("race_tie")+"\n", target, race.rounds)
+				default:
+					winner := strategyA.Name()
+					if race.winner == 1 {
+						winner = strategyB.Name()
+					}
+					outcome += fmt.Sprintf(tr("race_winner")+"\n", winner, target, race.rounds)
+				}
+			}
+			if fromMatchup {
+				outcome += fmt.Sprintf(tr("matchup_seed")+"\n", seed)
 			}
+			if game.err != nil {
+				outcome += game.err.Error() + "\n"
+			}
+			resultLabel.SetText(outcome)
+			showCards(matchCards(game))
+			correlationChart.SetValues(windowedCorrelation(game.movesA, game.movesB, correlationWindow))
+			correlationSection.Show()
+
+			// O histórico é só para consulta: uma falha de escrita não deve afetar a partida
+			if err := appendMatchLog(*historyFile, summarizeMatch(game, seed, target)); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+		})
 
-			resultLabel.SetText(output.String())
+		// Estratégia do dia: sorteia uma partida e a joga, informando a semente para reproduzi-la
+		matchupButton := widget.NewButton(tr("matchup_button"), func() {
+			seed := time.Now().UnixNano()
+			matchup := randomMatchup(rand.New(rand.NewSource(seed)))
+			strategyASelect.SetSelected(matchup.nameA)
+			strategyBSelect.SetSelected(matchup.nameB)
+			roundsEntry.SetText(strconv.Itoa(matchup.rounds))
+			matchupSeed = seed
+			startButton.OnTapped()
 		})
 
 		// Layout do modo normal
 		content := container.NewVBox(
-			widget.NewLabel("Escolha a Estratégia A:"),
+			container.NewBorder(nil, nil, widget.NewLabel(tr("category_label")), nil, categorySelect),
+			widget.NewLabel(tr("choose_a")),
 			strategyASelect,
-			widget.NewLabel("Escolha a Estratégia B:"),
+			descriptionA,
+			widget.NewLabel(tr("choose_b")),
 			strategyBSelect,
-			widget.NewLabel("Número de Rodadas:"),
+			descriptionB,
+			widget.NewLabel(tr("phased_label")),
+			container.NewGridWithColumns(3, phasedFirstSelect, phasedSecondSelect, phasedSwitchEntry),
+			tidemanParams,
+			selfRegulatorParams,
+			ratioTargeterParams,
+			memoryOneParams,
+			curveParams,
+			mixedParams,
+			neuralParams,
+			widget.NewLabel(tr("rounds_label")),
 			roundsEntry,
+			container.NewHBox(resetButton, undoButton),
+			widget.NewLabel(tr("payoff_label")),
+			payoffEntry,
+			widget.NewLabel(tr("seed_label")),
+			seedEntry,
+			widget.NewLabel(tr("coop_bonus_label")),
+			coopBonusEntry,
+			widget.NewLabel(tr("escalation_label")),
+			escalationEntry,
+			widget.NewLabel(tr("history_window_label")),
+			windowEntry,
+			widget.NewLabel(tr("start_score_label")),
+			container.NewGridWithColumns(2, startScoreEntryA, startScoreEntryB),
+			widget.NewLabel(tr("target_score_label")),
+			targetEntry,
+			swapCheck,
 			startButton,
-			widget.NewLabel("Progresso:"),
+			matchupButton,
+			exactButton,
+			widget.NewLabel(tr("progress")),
 			progressBar,
+			averageCheck,
+			averagesRow,
 			widget.NewSeparator(),
-			widget.NewLabel("Histórico das Rodadas:"),
+			widget.NewLabel(tr("history_label")),
+			searchButtons,
 			tableContainer,
+			exportGIFButton,
+			widget.NewLabel(tr("recap_label")),
+			recapContainer,
 			widget.NewSeparator(),
+			cardsGrid,
 			resultLabel,
+			correlationSection,
 		)
 
 		scroll := container.NewVScroll(content)
 		myWindow.SetContent(scroll)
-	})
+	}
 
-	allModeButton := widget.NewButton("Modo Todos Contra Todos", func() {
+	showTournamentMode := func() {
 		// Tela do modo "todos contra todos"
-		roundsEntry := widget.NewEntry()
-		roundsEntry.SetPlaceHolder("Digite o número de rodadas")
+		roundsEntry := newConfigEntry()
+		roundsEntry.SetPlaceHolder(tr("rounds_placeholder"))
+
+		// Repetições do torneio: com mais de uma, mostra a média e o intervalo de confiança de cada estratégia
+		repsEntry := newConfigEntry()
+
+		// Matriz de pontuação dos jogos e ruído: a probabilidade de cada jogada ser trocada pela oposta
+		payoffEntry := newConfigEntry()
+		noiseEntry := newConfigEntry()
+
+		// Semente do torneio (0 = sem semente, ou uma nova com a ordem embaralhada)
+		seedEntry := newConfigEntry()
+
+		// Sincroniza os campos com a configuração compartilhada
+		syncing := false
+		applyConfig := func() {
+			syncing = true
+			roundsEntry.SetText(strconv.Itoa(config.current.Rounds))
+			repsEntry.SetText(strconv.Itoa(config.current.Reps))
+			payoffEntry.SetText(formatPayoff(config.current.Payoff))
+			noiseEntry.SetText(strconv.FormatFloat(config.current.Noise, 'g', -1, 64))
+			seedEntry.SetText(strconv.FormatInt(config.current.Seed, 10))
+			syncing = false
+		}
+		// Grava os valores válidos dos campos na configuração; os inválidos mantêm o valor anterior
+		storeConfig := func() {
+			if syncing {
+				return
+			}
+			cfg := config.current
+			if rounds, err := strconv.Atoi(roundsEntry.Text); err == nil && rounds > 0 {
+				cfg.Rounds = rounds
+			}
+			if reps, err := strconv.Atoi(repsEntry.Text); err == nil && reps > 0 {
+				cfg.Reps = reps
+			}
+			if payoff, err := parsePayoff(payoffEntry.Text); err == nil {
+				cfg.Payoff = payoff
+			}
+			if noise, err := strconv.ParseFloat(noiseEntry.Text, 64); err == nil && noise >= 0 && noise <= 1 {
+				cfg.Noise = noise
+			}
+			if seed, err := strconv.ParseInt(seedEntry.Text, 10, 64); err == nil {
+				cfg.Seed = seed
+			}
+			config.Set(cfg)
+		}
+		for _, entry := range []*configEntry{roundsEntry, repsEntry, payoffEntry, noiseEntry, seedEntry} {
+			entry.onCommit = storeConfig
+		}
+		applyConfig()
+
+		resetButton := widget.NewButton(tr("reset_defaults"), func() {
+			config.Reset()
+			applyConfig()
+		})
+		undoButton := widget.NewButton(tr("undo"), func() {
+			if config.Undo() {
+				applyConfig()
+			}
+		})
+
+		// Número de cópias de cada estratégia, para modelar sua prevalência na população
+		countEntries := make(map[string]*widget.Entry, len(strategyNames))
+		countsGrid := container.NewGridWithColumns(4)
+		for _, name := range strategyNames {
+			entry := widget.NewEntry()
+			entry.SetText("1")
+			countEntries[name] = entry
+			countsGrid.Add(widget.NewLabel(name))
+			countsGrid.Add(entry)
+		}
+
+		// Seleção de uma categoria inteira: uma cópia de cada estratégia dela e nenhuma das demais
+		tournamentCategorySelect := widget.NewSelect(categoryLabels(), nil)
+		tournamentCategorySelect.SetSelectedIndex(int(CategoryNice))
+		categoryButton := widget.NewButton(tr("category_select_all"), func() {
+			chosen := make(map[string]bool)
+			for _, name := range strategiesInCategory(strategies, StrategyCategory(tournamentCategorySelect.SelectedIndex())) {
+				chosen[name] = true
+			}
+			for name, entry := range countEntries {
+				if chosen[name] {
+					entry.SetText("1")
+				} else {
+					entry.SetText("0")
+				}
+			}
+		})
 
-		outputLabel := widget.NewLabel("Resultado aparecerá aqui...")
+		// Intervalos de confiança, mostrados quando o torneio tem mais de uma repetição
+		confidenceChart := NewBarChart()
+		confidenceSection := container.NewVBox(widget.NewLabel(tr("confidence_label")), confidenceChart)
+		confidenceSection.Hide()
+
+		// Aprendizes persistentes: estratégias como Meta Learner mantêm o que aprenderam entre os jogos
+		persistentCheck := widget.NewCheck(tr("persistent_learners"), nil)
+
+		// Ordem embaralhada: com sementes por confronto, o resultado não deveria depender da ordem
+		shuffleCheck := widget.NewCheck(tr("shuffle_order"), nil)
+
+		// Classificação ajustada pela força dos oponentes, exibida junto com a normal
+		qualityCheck := widget.NewCheck(tr("quality_check"), nil)
+
+		// Janela de histórico: quantas rodadas anteriores as estratégias enxergam (0 = todas)
+		windowEntry := widget.NewEntry()
+		windowEntry.SetText("0")
+
+		// Critério de desempate da classificação
+		tieBreakSelect := widget.NewSelect(tieBreakLabels(), func(value string) {})
+		tieBreakSelect.SetSelectedIndex(int(TieBreakCooperation))
+
+		outputLabel := widget.NewLabel(tr("result_placeholder"))
 		outputLabel.Wrapping = fyne.TextWrapWord
 
-		startButton := widget.NewButton("Iniciar Torneio", func() {
+		// Classificação do último torneio, reordenada e filtrada sem rodar o torneio de novo
+		var lastResults []Result
+		lastRounds := 0
+		rankingLabel := widget.NewLabel("")
+		sortSelect := widget.NewSelect(resultSortLabels(), nil)
+		filterSelect := widget.NewSelect(resultFilterLabels(), nil)
+		scaleSelect := widget.NewSelect(scoreScaleLabels(), nil)
+		renderRanking := func() {
+			view := sortFilterResults(lastResults, ResultSort(sortSelect.SelectedIndex()), ResultFilter(filterSelect.SelectedIndex()))
+			var ranking strings.Builder
+			ranking.WriteString(tr("results_header") + "\n")
+			ranking.WriteString("------------------------------------------\n")
+			for _, result := range view {
+				score := formatScore(result, ScoreScale(scaleSelect.SelectedIndex()), lastRounds)
+				ranking.WriteString(fmt.Sprintf(tr("result_line")+"\n", result.rank, result.name, score))
+			}
+			if len(view) == 0 {
+				ranking.WriteString(tr("filter_empty") + "\n")
+			}
+			rankingLabel.SetText(ranking.String())
+		}
+		sortSelect.SetSelectedIndex(int(SortByScore))
+		filterSelect.SetSelectedIndex(int(FilterAll))
+		scaleSelect.SetSelectedIndex(int(ScaleTotal))
+		sortSelect.OnChanged = func(string) { renderRanking() }
+		filterSelect.OnChanged = func(string) { renderRanking() }
+		scaleSelect.OnChanged = func(string) { renderRanking() }
+		narrativeLabel := widget.NewLabel("")
+		narrativeLabel.Wrapping = fyne.TextWrapWord
+		classificationLabel := widget.NewLabelWithStyle("", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+		essLabel := widget.NewLabel("")
+		qualityLabel := widget.NewLabel("")
+		rankingSection := container.NewVBox(
+			classificationLabel,
+			container.NewGridWithColumns(3, widget.NewLabel(tr("sort_label")), widget.NewLabel(tr("filter_label")), widget.NewLabel(tr("scale_label"))),
+			container.NewGridWithColumns(3, sortSelect, filterSelect, scaleSelect),
+			rankingLabel,
+			narrativeLabel,
+			essLabel,
+			qualityLabel,
+		)
+		rankingSection.Hide()
+
+		// Gráfico de radar comparando as métricas das estratégias escolhidas pelo usuário
+		var metrics []StrategyMetrics
+		radarChart := NewRadarChart(metricAxes())
+		radarChecks := widget.NewCheckGroup(nil, func(selected []string) {
+			chosen := make(map[string]bool, len(selected))
+			for _, name := range selected {
+				chosen[name] = true
+			}
+			var picked []StrategyMetrics
+			for _, m := range metrics {
+				if chosen[m.name] {
+					picked = append(picked, m)
+				}
+			}
+			series := make([]RadarSeries, len(picked))
+			for i, values := range normalizeMetrics(picked) {
+				series[i] = RadarSeries{label: picked[i].name, values: values}
+			}
+			radarChart.SetSeries(series)
+		})
+		radarChecks.Horizontal = true
+		radarSection := container.NewVBox(widget.NewLabel(tr("radar_label")), radarChecks, radarChart)
+		radarSection.Hide()
+
+		// Curva da cooperação média ao longo das rodadas, em todos os confrontos
+		curveChart := NewLineChart()
+		curveSection := container.NewVBox(widget.NewLabel(tr("coop_curve_label")), curveChart)
+		curveSection.Hide()
+
+		// Grafo de "quem explora quem": setas para quem levou a pior no confronto direto
+		dominanceGraph := NewDominanceGraph()
+		dominanceSection := container.NewVBox(widget.NewLabel(tr("dominance_graph")), dominanceGraph)
+		dominanceSection.Hide()
+
+		// Comparação com um torneio anterior (A), guardado pelo usuário, para ver o efeito de mudar
+		// as configurações: cada novo torneio (B) é comparado com ele
+		var baseline []Result
+		compareLabel := widget.NewLabel("")
+		renderComparison := func() {
+			deltas := diffTournaments(baseline, lastResults)
+			// Destaca as estratégias que mais mudaram de posição
+			biggest := 0
+			for _, d := range deltas {
+				biggest = max(biggest, abs(d.moved()))
+			}
+			var comparison strings.Builder
+			comparison.WriteString(tr("compare_header") + "\n")
+			for _, d := range deltas {
+				switch {
+				case d.rankA == 0:
+					comparison.WriteString(fmt.Sprintf(tr("compare_only_b")+"\n", d.name, d.rankB, d.scoreB))
+				case d.rankB == 0:
+					comparison.WriteString(fmt.Sprintf(tr("compare_only_a")+"\n", d.name, d.rankA, d.scoreA))
+				default:
+					marker := "  "
+					if biggest > 0 && abs(d.moved()) == biggest {
+						marker = "» "
+					}
+					comparison.WriteString(marker + fmt.Sprintf(tr("compare_line")+"\n",
+						d.name, d.rankA, d.scoreA, d.rankB, d.scoreB, d.moved(), d.scoreB-d.scoreA))
+				}
+			}
+			compareLabel.SetText(comparison.String())
+		}
+		compareSection := container.NewVBox(widget.NewLabel(tr("compare_label")), compareLabel)
+		compareSection.Hide()
+		baselineButton := widget.NewButton(tr("compare_baseline"), func() {
+			baseline = lastResults
+			renderComparison()
+			compareSection.Show()
+		})
+		baselineButton.Disable()
+
+		// Exportação dos resultados como PNG, com uma legenda escrita pelo usuário, para compartilhar
+		captionEntry := widget.NewMultiLineEntry()
+		captionEntry.SetPlaceHolder(tr("share_caption"))
+		captionEntry.Wrapping = fyne.TextWrapWord
+		shareButton := widget.NewButton(tr("share_png"), func() {
+			var lines []string
+			for _, text := range []string{classificationLabel.Text, rankingLabel.Text, narrativeLabel.Text, essLabel.Text, outputLabel.Text} {
+				if text != "" {
+					lines = append(lines, strings.Split(strings.TrimRight(text, "\n"), "\n")...)
+					lines = append(lines, "")
+				}
+			}
+			dialog.ShowFileSave(func(writer fyne.URIWriteCloser, err error) {
+				if err != nil || writer == nil {
+					return
+				}
+				defer writer.Close()
+				if err := writeResultsPNG(writer, tr("share_title"), captionEntry.Text, lines); err != nil {
+					dialog.ShowError(err, myWindow)
+				}
+			}, myWindow)
+		})
+		shareButton.Disable()
+
+		// Classificação parcial, exibida enquanto o torneio está em andamento
+		liveLabel := widget.NewLabel("")
+		liveSection := container.NewVBox(widget.NewLabel(tr("live_board_label")), liveLabel)
+		liveSection.Hide()
+
+		var startButton *widget.Button
+		startButton = widget.NewButton(tr("start_tournament"), func() {
 			rounds, err := strconv.Atoi(roundsEntry.Text)
 			if err != nil || rounds <= 0 {
-				outputLabel.SetText("Por favor, insira um número de rodadas válido!")
+				outputLabel.SetText(tr("err_invalid_rounds"))
+				return
+			}
+			reps, err := strconv.Atoi(repsEntry.Text)
+			if err != nil || reps <= 0 {
+				outputLabel.SetText(tr("err_invalid_reps"))
+				return
+			}
+			window, err := strconv.Atoi(windowEntry.Text)
+			if err != nil || window < 0 {
+				outputLabel.SetText(tr("err_history_window"))
+				return
+			}
+			payoff, err := parsePayoff(payoffEntry.Text)
+			if err != nil {
+				outputLabel.SetText(err.Error())
+				return
+			}
+			noise, err := strconv.ParseFloat(noiseEntry.Text, 64)
+			if err != nil || noise < 0 || noise > 1 {
+				outputLabel.SetText(tr("err_noise"))
+				return
+			}
+			chosenSeed, err := strconv.ParseInt(seedEntry.Text, 10, 64)
+			if err != nil {
+				outputLabel.SetText(tr("err_seed"))
 				return
 			}
 
-			outputLabel.SetText("Processando...")
-			fyne.CurrentApp().Driver().CanvasForObject(outputLabel).Refresh(outputLabel)
+			// Lê o número de cópias de cada estratégia
+			weights := make(map[string]int, len(strategies))
+			for name, entry := range countEntries {
+				count, err := strconv.Atoi(entry.Text)
+				if err != nil || count < 0 {
+					outputLabel.SetText(fmt.Sprintf(tr("err_invalid_copies"), name))
+					return
+				}
+				weights[name] = count
+			}
+			counts := strategyCounts(strategies, weights)
+
+			// showTournamentResults exibe a classificação e as análises de um torneio terminado
+			showTournamentResults := func(results []Result, matrix [][]int, samples map[string][]float64, curve []float64, cfg TournamentConfig) {
+				// Sem resultados (por exemplo, todas as estratégias com zero cópias) não há o que exibir
+				if len(results) == 0 {
+					rankingSection.Hide()
+					outputLabel.SetText(tr("no_results"))
+					return
+				}
 
-			// Executa o torneio
-			results := runAllAgainstAll(strategies, rounds)
+				// Exibe a classificação, na ordem e com o filtro escolhidos
+				lastResults, lastRounds = results, rounds
+				renderRanking()
+				stats := tournamentStats(results, matrix, strategyNames, counts, samples, reps)
+				stats.settings = tournamentSettings(cfg, reps)
+				narrativeLabel.SetText(narrateTournament(stats, results))
+				classificationLabel.SetText(classifyTournament(stats))
 
-			// Exibe os resultados
-			var output strings.Builder
-			output.WriteString("Resultados Finais (ordenados por pontuação):\n")
-			output.WriteString("------------------------------------------\n")
-			for i, result := range results {
-				output.WriteString(fmt.Sprintf("%d. %s: %d pontos\n", i+1, result.name, result.score))
+				// Grava o torneio para revisão posterior; como o histórico, uma falha não afeta o torneio
+				if err := os.MkdirAll(*tournamentDir, 0o755); err != nil {
+					fmt.Fprintln(os.Stderr, err)
+				} else if err := saveTournamentResult(tournamentLogPath(*tournamentDir, time.Now()), recordTournament(stats, results, matrix, rounds)); err != nil {
+					fmt.Fprintln(os.Stderr, err)
+				}
+
+				// Estabilidade evolutiva das primeiras colocadas, entre as estratégias participantes
+				var participants []Strategy
+				for i, s := range strategies {
+					if counts[i] > 0 {
+						participants = append(participants, s)
+					}
+				}
+				var ess strings.Builder
+				ess.WriteString(tr("ess_header") + "\n")
+				for _, result := range results[:min(essTopCount, len(results))] {
+					status := tr("ess_invadable")
+					if isEvolutionarilyStable(participants, rounds, cfg.Seed, result.name) {
+						status = tr("ess_stable")
+					}
+					ess.WriteString(fmt.Sprintf(status+"\n", result.name))
+				}
+				essLabel.SetText(ess.String())
+
+				// Classificação ajustada pela força dos oponentes, com a variação em relação à normal
+				qualityLabel.SetText("")
+				if qualityCheck.Checked {
+					adjusted := qualityAdjustedScores(matrix, strategyNames)
+					ranked := make([]Result, len(results))
+					copy(ranked, results)
+					sort.SliceStable(ranked, func(i, j int) bool { return adjusted[ranked[i].name] > adjusted[ranked[j].name] })
+					var quality strings.Builder
+					quality.WriteString(tr("quality_header") + "\n")
+					for i, result := range ranked {
+						quality.WriteString(fmt.Sprintf(tr("quality_line")+"\n", i+1, result.name, adjusted[result.name], result.rank-(i+1)))
+					}
+					qualityLabel.SetText(quality.String())
+				}
+				rankingSection.Show()
+				baselineButton.Enable()
+				shareButton.Enable()
+				if baseline != nil {
+					renderComparison()
+				}
+
+				// Média e intervalo de confiança de 95% de cada estratégia ao longo das repetições
+				if reps > 1 {
+					bars := make([]Bar, len(results))
+					for i, result := range results {
+						mean, margin := confidenceInterval95(samples[result.name])
+						bars[i] = Bar{label: result.name, value: mean, margin: margin}
+					}
+					confidenceChart.SetBars(bars)
+					confidenceSection.Show()
+				}
+
+				// Configuração usada, para reproduzir o resultado, e os confrontos extremos: o mais
+				// cooperativo e o mais hostil
+				var output strings.Builder
+				output.WriteString(stats.settings + "\n")
+				if cooperative, hostile, ok := extremePairings(matrix, strategyNames, counts); ok {
+					output.WriteString(fmt.Sprintf(tr("most_cooperative")+"\n",
+						cooperative.nameA, cooperative.nameB, cooperative.combined))
+					output.WriteString(fmt.Sprintf(tr("most_hostile")+"\n",
+						hostile.nameA, hostile.nameB, hostile.combined))
+				}
+
+				// Desvio de cada estratégia em relação ao que Tit-for-Tat teria jogado
+				output.WriteString("\n" + tr("deviation_header") + "\n")
+				for _, result := range results {
+					output.WriteString(fmt.Sprintf(tr("deviation_line")+"\n", result.name, result.deviation*100))
+				}
+
+				// Estratégias desclassificadas em algum jogo por entrar em pânico
+				for _, result := range results {
+					if result.forfeits > 0 {
+						output.WriteString(fmt.Sprintf(tr("forfeit_line")+"\n", result.name, result.forfeits))
+					}
+				}
+
+				// Estratégias gentis (Axelrod): nunca foram as primeiras a trair em nenhum jogo
+				var nice []string
+				for _, result := range results {
+					if result.nice {
+						nice = append(nice, result.name)
+					}
+				}
+				output.WriteString("\n" + tr("nice_header") + "\n")
+				if len(nice) > 0 {
+					output.WriteString(strings.Join(nice, ", ") + "\n")
+				} else {
+					output.WriteString(tr("nice_none") + "\n")
+				}
+
+				// Estratégias dominadas: pioraram contra todos os oponentes em relação a outra
+				if relations := dominatedStrategies(matrix, strategyNames, counts); len(relations) > 0 {
+					output.WriteString("\n" + tr("dominated_header") + "\n")
+					for _, relation := range relations {
+						output.WriteString(fmt.Sprintf(tr("dominated_line")+"\n", relation.dominated, relation.by))
+					}
+				}
+
+				outputLabel.SetText(output.String())
+
+				// Métricas das estratégias participantes; o radar começa com as três primeiras colocadas
+				metrics = computeMetrics(participants, rounds)
+				options := make([]string, len(participants))
+				for i, s := range participants {
+					options[i] = s.Name()
+				}
+				radarChecks.Options = options
+				var top []string
+				for i := 0; i < len(results) && i < 3; i++ {
+					top = append(top, results[i].name)
+				}
+				radarChecks.SetSelected(top)
+				radarSection.Show()
+
+				curveChart.SetValues(curve)
+				curveSection.Show()
+
+				// Uma aresta exige, em média, mais de um ponto por rodada de vantagem no confronto
+				perGame, playedNames := perGameMatrix(matrix, strategyNames, counts)
+				dominanceGraph.SetGraph(playedNames, buildDominanceGraph(perGame, playedNames, rounds))
+				dominanceSection.Show()
 			}
 
-			outputLabel.SetText(output.String())
+			runTournament := func() {
+				rankingSection.Hide()
+				confidenceSection.Hide()
+				outputLabel.SetText(tr("processing"))
+				startButton.Disable()
+				live := &LiveStandings{}
+				liveLabel.SetText("")
+				liveSection.Show()
+
+				// Executa o torneio em segundo plano, redesenhando a classificação parcial enquanto ele roda
+				seed := chosenSeed
+				if seed == 0 && shuffleCheck.Checked {
+					seed = time.Now().UnixNano()
+				}
+				go func() {
+					done := make(chan struct{})
+					go func() {
+						ticker := time.NewTicker(liveRefresh)
+						defer ticker.Stop()
+						for {
+							select {
+							case <-done:
+								return
+							case <-ticker.C:
+								board := formatLiveStandings(live)
+								fyne.Do(func() { liveLabel.SetText(board) })
+							}
+						}
+					}()
+					cfg := TournamentConfig{
+						Rounds:   rounds,
+						Weights:  weights,
+						TieBreak: TieBreak(tieBreakSelect.SelectedIndex()),
+						Seed:     seed,
+						Shuffle:  shuffleCheck.Checked,
+
+						HistoryWindow:      window,
+						PersistentLearners: persistentCheck.Checked,
+						Payoff:             payoff,
+						Noise:              noise,
+						Live:               live,
+					}
+					results, matrix, samples, curve := repeatTournament(strategies, cfg, reps)
+					close(done)
+					// A interface só pode ser alterada na goroutine principal
+					fyne.Do(func() {
+						liveSection.Hide()
+						startButton.Enable()
+						showTournamentResults(results, matrix, samples, curve, cfg)
+					})
+				}()
+			}
+
+			// Torneios muito pesados pedem confirmação antes de começar
+			totalCopies := 0
+			for _, count := range counts {
+				totalCopies += count
+			}
+			work := estimateWork(totalCopies, reps, rounds)
+			if work > heavyTournamentRounds {
+				message := fmt.Sprintf(tr("heavy_message"), formatThousands(work))
+				dialog.ShowConfirm(tr("heavy_title"), message, func(ok bool) {
+					if ok {
+						runTournament()
+					}
+				}, myWindow)
+				return
+			}
+			runTournament()
 		})
 
 		// Layout do modo "todos contra todos"
 		content := container.NewVBox(
-			widget.NewLabel("Número de Rodadas:"),
+			widget.NewLabel(tr("rounds_label")),
 			roundsEntry,
+			container.NewHBox(resetButton, undoButton),
+			widget.NewLabel(tr("copies_label")),
+			container.NewBorder(nil, nil, widget.NewLabel(tr("category_label")), categoryButton, tournamentCategorySelect),
+			countsGrid,
+			widget.NewLabel(tr("reps_label")),
+			repsEntry,
+			widget.NewLabel(tr("tiebreak_label")),
+			tieBreakSelect,
+			widget.NewLabel(tr("history_window_label")),
+			windowEntry,
+			widget.NewLabel(tr("payoff_label")),
+			payoffEntry,
+			widget.NewLabel(tr("noise_label")),
+			noiseEntry,
+			widget.NewLabel(tr("tournament_seed_label")),
+			seedEntry,
+			persistentCheck,
+			shuffleCheck,
+			qualityCheck,
 			startButton,
 			widget.NewSeparator(),
+			liveSection,
+			rankingSection,
+			baselineButton,
+			captionEntry,
+			shareButton,
+			compareSection,
+			confidenceSection,
 			outputLabel,
+			radarSection,
+			curveSection,
+			dominanceSection,
 		)
 
 		scroll := container.NewVScroll(content)
 		myWindow.SetContent(scroll)
-	})
+	}
+
+	showHumanMode := func() {
+		// Tela do modo humano contra estratégia: o humano é o jogador B
+		opponentSelect := widget.NewSelect(strategyNames, func(value string) {})
+		opponentSelect.SetSelected(config.current.StrategyA)
+
+		// Desmarcado, o oponente joga anônimo e só é revelado ao fim (exercício "adivinhe a estratégia")
+		revealCheck := widget.NewCheck(tr("reveal_opponent"), nil)
+		revealCheck.SetChecked(true)
+
+		roundsEntry := widget.NewEntry()
+		roundsEntry.SetPlaceHolder(tr("rounds_placeholder"))
+		roundsEntry.SetText(strconv.Itoa(config.current.Rounds))
+
+		statusLabel := widget.NewLabel(tr("human_intro"))
+		statusLabel.Wrapping = fyne.TextWrapWord
+		historyLabel := widget.NewLabel("")
+		historyLabel.Wrapping = fyne.TextWrapWord
+
+		// Canal pelo qual os botões entregam as jogadas ao HumanStrategy
+		var humanMoves chan Choice
+		play := func(move Choice) {
+			if humanMoves == nil {
+				return
+			}
+			// Ignora cliques enquanto a jogada anterior ainda não foi consumida
+			select {
+			case humanMoves <- move:
+			default:
+			}
+		}
+		cooperateButton := widget.NewButton(tr("cooperate"), func() { play(Cooperate) })
+		defectButton := widget.NewButton(tr("defect"), func() { play(Defect) })
+		cooperateButton.Disable()
+		defectButton.Disable()
+
+		var startButton *widget.Button
+		startButton = widget.NewButton(tr("start_game"), func() {
+			rounds, err := strconv.Atoi(roundsEntry.Text)
+			if err != nil || rounds <= 0 {
+				statusLabel.SetText(tr("err_invalid_rounds"))
+				return
+			}
+			opponent := newStrategy(opponentSelect.Selected)
+			if opponent == nil {
+				statusLabel.SetText(tr("err_choose_opponent"))
+				return
+			}
+
+			humanMoves = make(chan Choice, 1)
+			game := NewGame(opponent, NewHumanStrategy(humanMoves), rounds)
+			revealed := revealCheck.Checked
+			shownName := opponentLabel(opponent.Name(), revealed)
+			if !revealed {
+				opponentSelect.Hide()
+			}
+			revealCheck.Disable()
+			startButton.Disable()
+			cooperateButton.Enable()
+			defectButton.Enable()
+			historyLabel.SetText("")
+			statusLabel.SetText(fmt.Sprintf(tr("human_turn"), 1, rounds))
+
+			// O jogo roda em segundo plano, pausando a cada rodada até o humano jogar
+			go func() {
+				var history strings.Builder
+				for i := 0; i < rounds; i++ {
+					if game.PlayRound(i) != nil {
+						break
+					}
+					history.WriteString(fmt.Sprintf(tr("human_history_line")+"\n",
+						i+1, moveText(game.movesB[i], displayMode), shownName, moveText(game.movesA[i], displayMode),
+						game.scores[1], game.scores[0]))
+					text, next := history.String(), i+2
+					// A interface só pode ser alterada na goroutine principal
+					fyne.Do(func() {
+						historyLabel.SetText(text)
+						if next <= rounds {
+							statusLabel.SetText(fmt.Sprintf(tr("human_turn"), next, rounds))
+						}
+					})
+				}
+
+				// O resultado final sempre revela a estratégia do oponente
+				outcome := formatOutcome(opponent.Name(), tr("you"), game.scores[0], game.scores[1])
+				if !revealed {
+					outcome = fmt.Sprintf(tr("opponent_revealed")+"\n", opponent.Name()) + outcome
+				}
+				if game.err != nil {
+					outcome += game.err.Error() + "\n"
+				}
+				fyne.Do(func() {
+					cooperateButton.Disable()
+					defectButton.Disable()
+					startButton.Enable()
+					revealCheck.Enable()
+					opponentSelect.Show()
+					statusLabel.SetText(outcome)
+				})
+			}()
+		})
+
+		// Layout do modo humano contra estratégia
+		content := container.NewVBox(
+			widget.NewLabel(tr("opponent_label")),
+			opponentSelect,
+			revealCheck,
+			widget.NewLabel(tr("rounds_label")),
+			roundsEntry,
+			startButton,
+			container.NewGridWithColumns(2, cooperateButton, defectButton),
+			widget.NewSeparator(),
+			statusLabel,
+			widget.NewSeparator(),
+			widget.NewLabel(tr("human_history")),
+			historyLabel,
+		)
+
+		scroll := container.NewVScroll(content)
+		myWindow.SetContent(scroll)
+	}
+
+	showGauntletMode := func() {
+		// Tela do desafio em sequência: o herói enfrenta os oponentes marcados, na ordem em que
+		// foram marcados, com a reputação dele passando de um oponente para o outro
+		heroSelect := widget.NewSelect(strategyNames, nil)
+		heroSelect.SetSelected(config.current.StrategyA)
+		opponentsCheck := widget.NewCheckGroup(strategyNames, nil)
+
+		roundsEntry := widget.NewEntry()
+		roundsEntry.SetPlaceHolder(tr("rounds_placeholder"))
+		roundsEntry.SetText(strconv.Itoa(config.current.Rounds))
+
+		outputLabel := widget.NewLabel("")
+		outputLabel.Wrapping = fyne.TextWrapWord
+
+		startButton := widget.NewButton(tr("gauntlet_start"), func() {
+			rounds, err := strconv.Atoi(roundsEntry.Text)
+			if err != nil || rounds <= 0 {
+				outputLabel.SetText(tr("err_invalid_rounds"))
+				return
+			}
+			hero := newStrategy(heroSelect.Selected)
+			if hero == nil {
+				outputLabel.SetText(tr("err_choose_opponent"))
+				return
+			}
+			var opponents []Strategy
+			for _, name := range opponentsCheck.Selected {
+				if s := newStrategy(name); s != nil {
+					opponents = append(opponents, s)
+				}
+			}
+			if len(opponents) == 0 {
+				outputLabel.SetText(tr("err_gauntlet_empty"))
+				return
+			}
+
+			matches := runGauntlet(hero, opponents, rounds, NewReputation())
+			var output strings.Builder
+			total := 0
+			for i, match := range matches {
+				total += match.heroScore
+				output.WriteString(fmt.Sprintf(tr("gauntlet_line")+"\n",
+					i+1, hero.Name(), match.heroScore, match.opponentScore, match.opponent))
+				if match.forfeited {
+					output.WriteString(tr("gauntlet_forfeit") + "\n")
+				}
+			}
+			output.WriteString(fmt.Sprintf("\n"+tr("gauntlet_total")+"\n",
+				hero.Name(), total, perRoundScore(total, 1, len(matches))))
+			outputLabel.SetText(output.String())
+		})
+
+		// Layout do desafio em sequência
+		content := container.NewVBox(
+			widget.NewLabel(tr("gauntlet_hero")),
+			heroSelect,
+			widget.NewLabel(tr("gauntlet_opponents")),
+			opponentsCheck,
+			widget.NewLabel(tr("rounds_label")),
+			roundsEntry,
+			startButton,
+			widget.NewSeparator(),
+			outputLabel,
+		)
+
+		scroll := container.NewVScroll(content)
+		myWindow.SetContent(scroll)
+	}
+
+	// Tela do histórico: partidas do modo normal já jogadas, da mais recente à mais antiga, cada uma
+	// podendo ser rejogada com a mesma configuração e semente
+	var showWelcome func()
+	showHistory := func() {
+		records, err := readMatchLog(*historyFile)
+		if err != nil {
+			dialog.ShowError(err, myWindow)
+		}
+		for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+			records[i], records[j] = records[j], records[i]
+		}
+
+		list := widget.NewList(
+			func() int { return len(records) },
+			func() fyne.CanvasObject {
+				return container.NewBorder(nil, nil, widget.NewButton(tr("history_replay"), nil), nil, widget.NewLabel(""))
+			},
+			func(id widget.ListItemID, item fyne.CanvasObject) {
+				record := records[id]
+				row := item.(*fyne.Container)
+				row.Objects[0].(*widget.Label).SetText(fmt.Sprintf(tr("history_line"),
+					record.Timestamp.Format("2006-01-02 15:04"), record.StrategyA, record.ScoreA,
+					record.StrategyB, record.ScoreB, record.Played, record.Seed))
+				row.Objects[1].(*widget.Button).OnTapped = func() {
+					game, err := replayMatch(record)
+					if err != nil {
+						dialog.ShowError(err, myWindow)
+						return
+					}
+					outcome := formatOutcome(game.strategyA.Name(), game.strategyB.Name(), game.scores[0], game.scores[1])
+					if game.scores != [2]int{record.ScoreA, record.ScoreB} {
+						outcome += tr("history_differs") + "\n"
+					}
+					dialog.ShowInformation(tr("history_replay"), outcome, myWindow)
+				}
+			},
+		)
+
+		var empty fyne.CanvasObject = layout.NewSpacer()
+		if len(records) == 0 {
+			empty = widget.NewLabel(tr("history_empty"))
+		}
+		header := container.NewVBox(widget.NewButton(tr("back"), func() { showWelcome() }), empty)
+		myWindow.SetContent(container.NewBorder(header, nil, nil, nil, list))
+	}
+
+	// Tela dos torneios gravados: cada um pode ser aberto para rever a classificação, as
+	// estatísticas e a matriz de confrontos, sem jogar o torneio de novo
+	var showTournamentLog func()
+	showTournamentResult := func(path string) {
+		stats, results, matrix, err := loadTournamentResult(path)
+		if err != nil {
+			dialog.ShowError(err, myWindow)
+			return
+		}
+		var ranking strings.Builder
+		ranking.WriteString(tr("results_header") + "\n")
+		for _, result := range results {
+			ranking.WriteString(fmt.Sprintf(tr("result_line")+"\n", result.rank, result.name, formatThousands(int64(result.score))))
+		}
+		narrativeLabel := widget.NewLabel(narrateTournament(stats, results))
+		narrativeLabel.Wrapping = fyne.TextWrapWord
+		content := container.NewVBox(
+			widget.NewButton(tr("back"), func() { showTournamentLog() }),
+			widget.NewLabel(filepath.Base(path)),
+			widget.NewLabelWithStyle(classifyTournament(stats), fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
+			widget.NewLabel(ranking.String()),
+			narrativeLabel,
+			widget.NewLabel(stats.settings),
+			widget.NewLabel(tr("tournament_log_matrix")),
+			widget.NewLabelWithStyle(formatMatrix(stats.names, matrix), fyne.TextAlignLeading, fyne.TextStyle{Monospace: true}),
+		)
+		myWindow.SetContent(container.NewScroll(content))
+	}
+	showTournamentLog = func() {
+		paths, err := tournamentLogFiles(*tournamentDir)
+		if err != nil {
+			dialog.ShowError(err, myWindow)
+		}
+		list := widget.NewList(
+			func() int { return len(paths) },
+			func() fyne.CanvasObject {
+				return container.NewBorder(nil, nil, widget.NewButton(tr("tournament_log_open"), nil), nil, widget.NewLabel(""))
+			},
+			func(id widget.ListItemID, item fyne.CanvasObject) {
+				path := paths[id]
+				row := item.(*fyne.Container)
+				row.Objects[0].(*widget.Label).SetText(filepath.Base(path))
+				row.Objects[1].(*widget.Button).OnTapped = func() { showTournamentResult(path) }
+			},
+		)
+
+		var empty fyne.CanvasObject = layout.NewSpacer()
+		if len(paths) == 0 {
+			empty = widget.NewLabel(tr("tournament_log_empty"))
+		}
+		header := container.NewVBox(widget.NewButton(tr("back"), func() { showWelcome() }), empty)
+		myWindow.SetContent(container.NewBorder(header, nil, nil, nil, list))
+	}
+
+	// Tela inicial: escolha entre os modos, o tema e o idioma; é reconstruída ao trocar de idioma
+	showWelcome = func() {
+		myWindow.SetTitle(tr("app_title"))
+		welcomeLabel := widget.NewLabel(tr("welcome"))
+		welcomeLabel.Alignment = fyne.TextAlignCenter
+
+		// Alternância entre tema claro e escuro, restaurada na próxima execução
+		themeCheck := widget.NewCheck(tr("dark_theme"), func(dark bool) {
+			applyTheme(myApp, dark)
+		})
+		themeCheck.SetChecked(myApp.Preferences().Bool(themePreferenceKey))
+
+		// Seletor de idioma, também restaurado na próxima execução
+		languageSelect := widget.NewSelect(languages, nil)
+		languageSelect.SetSelected(language)
+		languageSelect.OnChanged = func(lang string) {
+			if lang == language {
+				return
+			}
+			setLanguage(myApp, lang)
+			showWelcome()
+		}
+
+		// Forma de exibir as jogadas (emoji, letras ou só cor), também restaurada na próxima execução
+		displayLabels := displayModeLabels()
+		displaySelect := widget.NewSelect(displayLabels, nil)
+		displaySelect.SetSelected(displayLabels[displayMode])
+		displaySelect.OnChanged = func(label string) {
+			for i, l := range displayLabels {
+				if l == label {
+					setDisplayMode(myApp, DisplayMode(i))
+				}
+			}
+		}
+
+		// Carrega uma estratégia compilada como plugin Go e a acrescenta às listas das telas
+		pluginButton := widget.NewButton(tr("load_plugin"), func() {
+			dialog.ShowFileOpen(func(reader fyne.URIReadCloser, err error) {
+				if err != nil || reader == nil {
+					return
+				}
+				path := reader.URI().Path()
+				reader.Close()
+				s, err := LoadStrategyPlugin(path)
+				if err != nil {
+					dialog.ShowError(err, myWindow)
+					return
+				}
+				strategies = append(strategies, s)
+				strategyNames = append(strategyNames, s.Name())
+				dialog.ShowInformation(tr("load_plugin"), fmt.Sprintf(tr("plugin_loaded"), s.Name()), myWindow)
+			}, myWindow)
+		})
+
+		// Autoteste: o mesmo torneio jogado duas vezes com a mesma semente deve dar o mesmo resultado
+		selfTestButton := widget.NewButton(tr("self_test"), func() {
+			if err := selfTestDeterminism(selfTestSeed); err != nil {
+				dialog.ShowError(err, myWindow)
+				return
+			}
+			dialog.ShowInformation(tr("self_test"), tr("self_test_passed"), myWindow)
+		})
 
-	// Layout da tela inicial
-	content := container.NewVBox(
-		welcomeLabel,
-		normalModeButton,
-		allModeButton,
-	)
-	myWindow.SetContent(container.New(layout.NewCenterLayout(), content))
+		// Layout da tela inicial
+		content := container.NewVBox(
+			welcomeLabel,
+			widget.NewButton(tr("mode_normal"), showNormalMode),
+			widget.NewButton(tr("mode_tournament"), showTournamentMode),
+			widget.NewButton(tr("mode_human"), showHumanMode),
+			widget.NewButton(tr("mode_gauntlet"), showGauntletMode),
+			widget.NewButton(tr("mode_history"), showHistory),
+			widget.NewButton(tr("mode_tournament_log"), showTournamentLog),
+			pluginButton,
+			selfTestButton,
+			themeCheck,
+			container.NewHBox(widget.NewLabel(tr("language")), languageSelect),
+			container.NewHBox(widget.NewLabel(tr("display_mode")), displaySelect),
+		)
+		myWindow.SetContent(container.New(layout.NewCenterLayout(), content))
+	}
+	showWelcome()
 
 	// Inicia a aplicação
 	myWindow.ShowAndRun()
@@ -0,0 +1,1676 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// panicSource é uma fonte aleatória que entra em pânico se for usada, para detectar estratégias
+// que sorteiam com o rng global em vez do gerador recebido do jogo
+type panicSource struct{}
+
+func (panicSource) Int63() int64 { panic("sorteio com o rng global") }
+func (panicSource) Seed(int64)   {}
+
+// forbidGlobalRNG faz o teste falhar (com pânico) se algo sortear com o rng global até o fim dele
+func forbidGlobalRNG(t *testing.T) {
+	t.Helper()
+	saved := rng
+	rng = rand.New(panicSource{})
+	t.Cleanup(func() { rng = saved })
+}
+
+// playMatch joga rounds rodadas entre a e b, com os geradores das estratégias semeados por seed;
+// um pânico de alguma delas (inclusive o de forbidGlobalRNG) encerra o teste
+func playMatch(t *testing.T, a, b Strategy, rounds int, seed int64) *Game {
+	t.Helper()
+	game := NewGame(a, b, rounds)
+	game.SeedStrategies(seed)
+	for round := 0; round < rounds; round++ {
+		if err := game.PlayRound(round); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return game
+}
+
+// movesString escreve as jogadas como uma sequência de C e D
+func movesString(moves []Choice) string {
+	b := make([]byte, len(moves))
+	for i, move := range moves {
+		b[i] = moveCode(move)[0]
+	}
+	return string(b)
+}
+
+// parseMoves lê uma sequência de C e D, o inverso de movesString
+func parseMoves(text string) []Choice {
+	moves := make([]Choice, len(text))
+	for i := range text {
+		if text[i] == 'D' {
+			moves[i] = Defect
+		} else {
+			moves[i] = Cooperate
+		}
+	}
+	return moves
+}
+
+// periodicDefector trai a cada period rodadas (na última de cada período) e coopera nas outras
+type periodicDefector struct{ period int }
+
+func (s periodicDefector) NextMove(round int, _ []Choice) Choice {
+	if (round+1)%s.period == 0 {
+		return Defect
+	}
+	return Cooperate
+}
+func (s periodicDefector) Name() string { return "Periodic Defector" }
+
+// retaliationRate retorna a fração das traições de b (exceto a última) a que a respondeu traindo
+func retaliationRate(game *Game) float64 {
+	defections, retaliations := 0, 0
+	for i := 0; i+1 < len(game.movesB); i++ {
+		if game.movesB[i] == Defect {
+			defections++
+			if game.movesA[i+1] == Defect {
+				retaliations++
+			}
+		}
+	}
+	return float64(retaliations) / float64(defections)
+}
+
+func TestProportionalRetaliator(t *testing.T) {
+	forbidGlobalRNG(t)
+
+	// Contra quem sempre trai, a taxa de traição é 1: retalia sempre
+	if rate := retaliationRate(playMatch(t, &ProportionalRetaliator{}, AlwaysDefect{}, 500, 1)); rate < 0.99 {
+		t.Errorf("retaliação contra Always Defect = %.2f, esperado quase 1", rate)
+	}
+	// Contra quem trai uma vez a cada 10 rodadas, retalia com probabilidade perto de 0,1
+	if rate := retaliationRate(playMatch(t, &ProportionalRetaliator{}, periodicDefector{10}, 5000, 1)); rate > 0.25 {
+		t.Errorf("retaliação contra quem raramente trai = %.2f, esperado perdão frequente", rate)
+	}
+
+	// Com a mesma semente, o jogo se repete
+	first := playMatch(t, &ProportionalRetaliator{}, periodicDefector{3}, 300, 5)
+	second := playMatch(t, &ProportionalRetaliator{}, periodicDefector{3}, 300, 5)
+	if movesString(first.movesA) != movesString(second.movesA) {
+		t.Error("jogos com a mesma semente deram jogadas diferentes")
+	}
+}
+
+// biasedRandom coopera com probabilidade p, sorteando com o gerador recebido do jogo
+type biasedRandom struct {
+	randomized
+	p float64
+}
+
+func (s *biasedRandom) NextMove(int, []Choice) Choice {
+	if s.random().Float64() < s.p {
+		return Cooperate
+	}
+	return Defect
+}
+func (s *biasedRandom) Name() string { return "Biased Random" }
+
+func TestReflectiveConvergesToOpponentFrequency(t *testing.T) {
+	forbidGlobalRNG(t)
+	game := playMatch(t, &Reflective{}, &biasedRandom{p: 0.3}, 10000, 3)
+	if rate := cooperationRate(game.movesA); rate < 0.27 || rate > 0.33 {
+		t.Errorf("cooperação do Reflective contra um oponente que coopera 30%% = %.3f", rate)
+	}
+	if game.movesA[0] != Cooperate {
+		t.Error("o Reflective deveria cooperar na primeira rodada")
+	}
+}
+
+func TestMetaLearnerTrendsTowardForgiveness(t *testing.T) {
+	forbidGlobalRNG(t)
+
+	// Contra Joss, que trai ao acaso, perdoar evita ecos de retaliação e rende mais: ao longo dos
+	// jogos, com a mesma instância, a probabilidade de perdão deve subir a partir de 0,1
+	const matches, rounds = 30, 200
+	total := 0.0
+	for run := int64(1); run <= 8; run++ {
+		s := NewMetaLearner(0.1, 0.1)
+		for match := int64(0); match < matches; match++ {
+			game := playMatch(t, s, &Joss{}, rounds, run*100+match)
+			s.Learn(game.scores[0], rounds)
+		}
+		if s.forgiveness <= s.initial {
+			t.Errorf("execução %d: perdão final %.2f não subiu a partir de %.2f", run, s.forgiveness, s.initial)
+		}
+		total += s.forgiveness
+	}
+	if mean := total / 8; mean < 0.5 {
+		t.Errorf("perdão médio final = %.2f, esperado pelo menos 0,5", mean)
+	}
+}
+
+func TestPersistentLearnersKeepStateAcrossMatches(t *testing.T) {
+	forbidGlobalRNG(t)
+	strategies := []Strategy{NewMetaLearner(0.1, 0.1), &Joss{}, AlwaysDefect{}}
+	for _, persistent := range []bool{false, true} {
+		checkpoint := newTournamentCheckpoint(strategies, TournamentConfig{Rounds: 50, Seed: 1, PersistentLearners: persistent})
+		checkpoint.advance(strategies, -1)
+		learner, kept := checkpoint.learners[0].(*MetaLearner)
+		if kept != persistent {
+			t.Fatalf("aprendizes persistentes = %v, mas instância guardada = %v", persistent, kept)
+		}
+		if persistent && learner.forgiveness == learner.initial {
+			t.Error("o Meta Learner persistente não aprendeu nada ao longo do torneio")
+		}
+	}
+}
+
+func TestCatchUpDefectsMoreWhenFurtherBehind(t *testing.T) {
+	forbidGlobalRNG(t)
+	const rounds, samples = 20, 4000
+	previous := -1.0
+	for exploited := 0; exploited <= rounds; exploited += 5 {
+		// Histórico simulado: CatchUp cooperou em todas as rodadas e foi traído em exploited delas
+		opponent := make([]Choice, rounds)
+		for i := 0; i < exploited; i++ {
+			opponent[i] = Defect
+		}
+		s := NewCatchUp(10)
+		s.SetRand(rand.New(rand.NewSource(int64(exploited))))
+		defections := 0
+		for i := 0; i < samples; i++ {
+			s.ownMoves = make([]Choice, rounds)
+			if s.NextMove(rounds, opponent) == Defect {
+				defections++
+			}
+		}
+		rate := float64(defections) / samples
+		if exploited == 0 && rate != 0 {
+			t.Errorf("sem desvantagem, CatchUp traiu em %.3f das vezes", rate)
+		}
+		if rate <= previous {
+			t.Errorf("com %d rodadas de desvantagem, taxa de traição %.3f não passou de %.3f", exploited, rate, previous)
+		}
+		previous = rate
+	}
+}
+
+// resultNames retorna os nomes dos resultados, na ordem
+func resultNames(results []Result) []string {
+	names := make([]string, len(results))
+	for i, result := range results {
+		names[i] = result.name
+	}
+	return names
+}
+
+func TestSortFilterResults(t *testing.T) {
+	results := []Result{
+		{name: "Delta", rank: 1, coopRate: 0.2, nice: false, stateless: true},
+		{name: "Alpha", rank: 2, coopRate: 0.9, nice: true, stateless: false},
+		{name: "Charlie", rank: 3, coopRate: 0.9, nice: true, stateless: true},
+		{name: "Bravo", rank: 4, coopRate: 0.5, nice: false, stateless: false},
+	}
+	cases := []struct {
+		by     ResultSort
+		filter ResultFilter
+		want   []string
+	}{
+		{SortByScore, FilterAll, []string{"Delta", "Alpha", "Charlie", "Bravo"}},
+		{SortByName, FilterAll, []string{"Alpha", "Bravo", "Charlie", "Delta"}},
+		{SortByCooperation, FilterAll, []string{"Alpha", "Charlie", "Bravo", "Delta"}},
+		{SortByScore, FilterNice, []string{"Alpha", "Charlie"}},
+		{SortByName, FilterNice, []string{"Alpha", "Charlie"}},
+		{SortByCooperation, FilterNice, []string{"Alpha", "Charlie"}},
+		{SortByScore, FilterStateless, []string{"Delta", "Charlie"}},
+		{SortByName, FilterStateless, []string{"Charlie", "Delta"}},
+		{SortByCooperation, FilterStateless, []string{"Charlie", "Delta"}},
+	}
+	for _, c := range cases {
+		if got := resultNames(sortFilterResults(results, c.by, c.filter)); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("ordem %d, filtro %d: %v, esperado %v", c.by, c.filter, got, c.want)
+		}
+	}
+	if results[0].name != "Delta" {
+		t.Error("sortFilterResults alterou a classificação original")
+	}
+}
+
+func TestStatelessFlagComesFromTheStrategy(t *testing.T) {
+	strategies := []Strategy{TitForTat{}, &Joss{}, NewMetaLearner(0.1, 0.1), &EscalatingPunisher{}}
+	results, _ := runAllAgainstAll(strategies, TournamentConfig{Rounds: 10, Seed: 1})
+	want := map[string]bool{"Tit-for-Tat": true, "Joss": true, "Meta Learner": false, "Escalating Punisher": false}
+	for _, result := range results {
+		if result.stateless != want[result.name] {
+			t.Errorf("%s: sem estado = %v, esperado %v", result.name, result.stateless, want[result.name])
+		}
+	}
+}
+
+func TestNiceStrategiesNeverDefectFirst(t *testing.T) {
+	strategies := []Strategy{TitForTat{}, AlwaysCooperate{}, AlwaysDefect{}}
+	checkpoint := newTournamentCheckpoint(strategies, TournamentConfig{Rounds: 20, Seed: 1})
+	checkpoint.advance(strategies, -1)
+	results, _ := checkpoint.results(strategies)
+	want := map[string]bool{"Tit-for-Tat": true, "Always Cooperate": true, "Always Defect": false}
+	for _, result := range results {
+		if result.nice != want[result.name] {
+			t.Errorf("%s: amável = %v, esperado %v", result.name, result.nice, want[result.name])
+		}
+	}
+
+	// Por confronto (jogado nas duas ordens): Always Defect trai primeiro, e Tit-for-Tat só responde
+	if got := checkpoint.FirstDefectors[2][0]; got != 2 {
+		t.Errorf("Always Defect traiu primeiro contra Tit-for-Tat em %d jogos, esperado 2", got)
+	}
+	if got := checkpoint.FirstDefectors[0][2]; got != 0 {
+		t.Errorf("Tit-for-Tat traiu primeiro contra Always Defect em %d jogos, esperado 0", got)
+	}
+}
+
+// panicker coopera até a rodada from e então entra em pânico, como um plugin com defeito
+type panicker struct{ from int }
+
+func (s panicker) NextMove(round int, _ []Choice) Choice {
+	if round >= s.from {
+		panic("falha de propósito")
+	}
+	return Cooperate
+}
+func (s panicker) Name() string { return "Panicker" }
+
+func TestPanickingStrategyForfeitsAndTournamentContinues(t *testing.T) {
+	// No jogo, a estratégia que entra em pânico fica com zero e o jogo para ali
+	game := NewGame(panicker{from: 3}, AlwaysCooperate{}, 10)
+	var err error
+	for round := 0; round < 10 && err == nil; round++ {
+		err = game.PlayRound(round)
+	}
+	if err == nil {
+		t.Fatal("o pânico da estratégia não virou erro")
+	}
+	if game.forfeited != [2]bool{true, false} || game.scores[0] != 0 || len(game.movesA) != 3 {
+		t.Errorf("desclassificação = %v, pontos %v, %d rodadas; esperado só A desclassificada, com zero, após 3 rodadas",
+			game.forfeited, game.scores, len(game.movesA))
+	}
+	if game.PlayRound(3) == nil {
+		t.Error("um jogo interrompido deveria continuar retornando o erro")
+	}
+
+	// No torneio, os outros confrontos são jogados normalmente
+	strategies := []Strategy{panicker{from: 2}, TitForTat{}, AlwaysDefect{}}
+	checkpoint := newTournamentCheckpoint(strategies, TournamentConfig{Rounds: 10, Seed: 1})
+	if !checkpoint.advance(strategies, -1) {
+		t.Fatal("o torneio não terminou")
+	}
+	// Panicker joga contra cada uma nas duas ordens e, contra si mesmo, é desclassificada dos dois lados
+	if got := checkpoint.Forfeits["Panicker"]; got != 6 {
+		t.Errorf("Panicker desclassificada em %d jogos, esperado 6", got)
+	}
+	// Tit-for-Tat: 10 rodadas de R contra si mesmo (dos dois lados), S e 9 P contra Always Defect e as
+	// 2 rodadas de R jogadas contra Panicker antes do pânico, nas duas ordens
+	m := defaultPayoff
+	want := 2*10*m.Reward + 2*(m.Sucker+9*m.Punishment) + 2*2*m.Reward
+	if got := checkpoint.TotalScores["Tit-for-Tat"]; got != want {
+		t.Errorf("Tit-for-Tat fez %d pontos, esperado %d", got, want)
+	}
+}
+
+func TestRunUntilScore(t *testing.T) {
+	m := defaultPayoff
+	cases := []struct {
+		name           string
+		a, b           Strategy
+		startA, target int
+		cap            int
+		want           ScoreRace
+	}{
+		// Always Defect soma T por rodada e chega ao alvo antes de Always Cooperate
+		{"primeiro a chegar", AlwaysDefect{}, AlwaysCooperate{}, 0, 2 * m.Temptation, 100, ScoreRace{rounds: 2, reached: true, winner: 0}},
+		// Os dois cooperam e cruzam o alvo juntos, com a mesma pontuação: empate
+		{"empate no cruzamento", AlwaysCooperate{}, AlwaysCooperate{}, 0, 3 * m.Reward, 100, ScoreRace{rounds: 3, reached: true, winner: -1}},
+		// Cruzam juntos, mas A começou com um ponto a mais: vence quem tem mais pontos
+		{"cruzamento com vantagem", AlwaysCooperate{}, AlwaysCooperate{}, 1, 3 * m.Reward, 100, ScoreRace{rounds: 3, reached: true, winner: 0}},
+		// Ninguém chega ao alvo antes do limite de rodadas
+		{"limite", AlwaysDefect{}, AlwaysDefect{}, 0, 1000, 7, ScoreRace{rounds: 7, reached: false, winner: -1}},
+	}
+	for _, c := range cases {
+		game := NewGame(c.a, c.b, c.cap)
+		game.SetStartingScores(c.startA, 0)
+		race, err := game.RunUntilScore(c.target, c.cap)
+		if err != nil || race != c.want {
+			t.Errorf("%s: %+v, %v; esperado %+v", c.name, race, err, c.want)
+		}
+	}
+
+	// Um replay do histórico para na mesma rodada que a partida original
+	record := MatchSummary{StrategyA: "Always Cooperate", StrategyB: "Always Cooperate", Rounds: 100, Target: 3 * m.Reward}
+	game, err := replayMatch(record)
+	if err != nil || len(game.movesA) != 3 {
+		t.Errorf("replay até %d pontos jogou %d rodadas (%v), esperado 3", record.Target, len(game.movesA), err)
+	}
+}
+
+func TestTournamentTotalsDoNotDependOnStrategyOrder(t *testing.T) {
+	forbidGlobalRNG(t)
+	ordered := []Strategy{TitForTat{}, &Joss{}, &Random{}, &Feld{}, AlwaysDefect{}}
+	reversed := make([]Strategy, len(ordered))
+	for i, s := range ordered {
+		reversed[len(ordered)-1-i] = s
+	}
+	cfg := TournamentConfig{Rounds: 50, Seed: 11}
+	want := playTournament(ordered, cfg).TotalScores
+	if got := playTournament(reversed, cfg).TotalScores; !reflect.DeepEqual(got, want) {
+		t.Errorf("totais na ordem invertida %v, esperado %v", got, want)
+	}
+	cfg.Shuffle = true
+	if got := playTournament(ordered, cfg).TotalScores; !reflect.DeepEqual(got, want) {
+		t.Errorf("totais com os confrontos embaralhados %v, esperado %v", got, want)
+	}
+}
+
+func TestTimeHealerCooperationRisesAfterDefection(t *testing.T) {
+	const samples = 4000
+	s := &TimeHealer{}
+	s.SetRand(rand.New(rand.NewSource(1)))
+	previous := -1.0
+	// Histórico do oponente: uma traição seguida de k-1 cooperações, ou seja, k rodadas desde ela
+	for k := 1; k <= timeHealerRounds+1; k++ {
+		opponent := []Choice{Cooperate, Defect}
+		for i := 1; i < k; i++ {
+			opponent = append(opponent, Cooperate)
+		}
+		cooperations := 0
+		for i := 0; i < samples; i++ {
+			if s.NextMove(len(opponent), opponent) == Cooperate {
+				cooperations++
+			}
+		}
+		rate := float64(cooperations) / samples
+		want := math.Min(1, float64(k)/timeHealerRounds)
+		if math.Abs(rate-want) > 0.03 {
+			t.Errorf("%d rodada(s) após a traição: cooperou em %.3f, esperado perto de %.2f", k, rate, want)
+		}
+		if k <= timeHealerRounds && rate <= previous {
+			t.Errorf("%d rodada(s) após a traição: cooperação %.3f não subiu de %.3f", k, rate, previous)
+		}
+		previous = rate
+	}
+}
+
+// titForTwoTats só trai depois de duas traições seguidas do oponente
+type titForTwoTats struct{}
+
+func (titForTwoTats) NextMove(round int, opponentMoves []Choice) Choice {
+	if n := len(opponentMoves); n >= 2 && opponentMoves[n-1] == Defect && opponentMoves[n-2] == Defect {
+		return Defect
+	}
+	return Cooperate
+}
+func (titForTwoTats) Name() string { return "Tit-for-Two-Tats" }
+
+func TestThresholdProberSettlesOnSingleDefections(t *testing.T) {
+	game := playMatch(t, &ThresholdProber{}, titForTwoTats{}, 200, 1)
+	// A rajada de duas traições provoca retaliação; depois disso o Prober volta a traições isoladas,
+	// uma a cada ciclo, e Tit-for-Two-Tats nunca mais retalia
+	if moves := movesString(game.movesA[:7]); moves != "CCDCCDD" {
+		t.Errorf("sondagem %s, esperado CCDCCDD", moves)
+	}
+	tailA, tailB := movesString(game.movesA[10:]), movesString(game.movesB[10:])
+	if want := strings.Repeat("CCD", len(tailA)/3); !strings.HasPrefix(tailA, want) {
+		t.Errorf("depois da sondagem o Prober jogou %s, esperado traições isoladas a cada três rodadas", tailA)
+	}
+	if strings.Contains(tailB, "D") {
+		t.Errorf("Tit-for-Two-Tats retaliou depois da sondagem: %s", tailB)
+	}
+}
+
+func TestDefectionEscalationAndRecap(t *testing.T) {
+	// Com P = 3 e S = 0, a penalidade pode chegar a 3 antes de bater no piso
+	m := PayoffMatrix{Reward: 4, Sucker: 0, Temptation: 6, Punishment: 3}
+	// Traição mútua em todas as rodadas: P, P-1, P-2 e, no piso, S
+	game := NewGame(AlwaysDefect{}, AlwaysDefect{}, 4)
+	game.SetPayoff(m)
+	game.SetDefectionEscalation(1)
+	game.SetStartingScores(10, 0)
+	var history []roundData
+	for round := 0; round < 4; round++ {
+		if err := game.PlayRound(round); err != nil {
+			t.Fatal(err)
+		}
+		history = appendRound(history, game)
+	}
+	for i, want := range []int{3, 2, 1, 0} {
+		if history[i].pointsA != want || history[i].pointsB != want {
+			t.Errorf("rodada %d de traição mútua: pontos (%d, %d), esperado %d", i+1, history[i].pointsA, history[i].pointsB, want)
+		}
+	}
+
+	// O resumo acessível mostra o placar do jogo, com pontuação inicial e penalidades
+	recap := matchRecap("A", "B", history)
+	want := fmt.Sprintf(tr("recap_sentence"), 3, "A", moveVerb(Defect), "B", moveVerb(Defect), 16, 6)
+	if recap[2] != want {
+		t.Errorf("resumo da 3ª rodada %q, esperado %q", recap[2], want)
+	}
+}
+
+func TestSelfRegulatorHoldsTargetFrequency(t *testing.T) {
+	for _, target := range []float64{0.6, 0.25, 1.0 / 3, 0, 1} {
+		game := playMatch(t, NewSelfRegulator(target), &Joss{}, 1000, 1)
+		// A cada rodada, as cooperações ficam a no máximo meia jogada do alvo
+		cooperations := 0
+		for i, move := range game.movesA {
+			if move == Cooperate {
+				cooperations++
+			}
+			if diff := math.Abs(float64(cooperations) - target*float64(i+1)); diff > 0.5+1e-9 {
+				t.Errorf("alvo %.2f: após %d rodadas, %d cooperações, longe demais do alvo", target, i+1, cooperations)
+				break
+			}
+		}
+		if rate := cooperationRate(game.movesA); math.Abs(rate-target) > 0.001 {
+			t.Errorf("alvo %.2f: cooperação final %.4f", target, rate)
+		}
+	}
+}
+
+func TestPhaseMatcherLocksOntoAlternator(t *testing.T) {
+	game := playMatch(t, PhaseMatcher{}, Alternator{}, 40, 1)
+	// Depois de ver o padrão duas vezes, joga em fase com o Alternator: coopera junto nas rodadas
+	// em que ele coopera e trai junto nas outras, sem ser explorado
+	lock := 2 * 2
+	if a, b := movesString(game.movesA[lock:]), movesString(game.movesB[lock:]); a != b {
+		t.Errorf("fora de fase depois da rodada %d:\n%s\n%s", lock, a, b)
+	}
+	mutual := 0
+	for i := lock; i < len(game.movesA); i++ {
+		if game.movesA[i] == Cooperate && game.movesB[i] == Cooperate {
+			mutual++
+		}
+	}
+	if want := (len(game.movesA) - lock) / 2; mutual != want {
+		t.Errorf("%d rodadas de cooperação mútua depois da detecção, esperado %d", mutual, want)
+	}
+
+	// Tit-for-Tat, sem detectar o período, fica defasado e nunca coopera junto com o Alternator
+	tft := playMatch(t, TitForTat{}, Alternator{}, 40, 1)
+	for i := lock; i < len(tft.movesA); i++ {
+		if tft.movesA[i] == Cooperate && tft.movesB[i] == Cooperate {
+			t.Fatalf("Tit-for-Tat cooperou junto com o Alternator na rodada %d", i+1)
+		}
+	}
+}
+
+func TestMinimaxRegretDependsOnMatrix(t *testing.T) {
+	// No dilema clássico, trair nunca traz arrependimento: T é a melhor resposta à cooperação e P à traição
+	classic := PayoffMatrix{Reward: 3, Sucker: 0, Temptation: 5, Punishment: 1}
+	// Numa caça ao cervo (R > T), cooperar é a melhor resposta à cooperação
+	stagHunt := PayoffMatrix{Reward: 5, Sucker: 0, Temptation: 3, Punishment: 1}
+	cases := []struct {
+		name     string
+		m        PayoffMatrix
+		opponent Strategy
+		want     string
+	}{
+		{"clássica contra quem coopera", classic, AlwaysCooperate{}, strings.Repeat("D", 10)},
+		{"caça ao cervo contra quem coopera", stagHunt, AlwaysCooperate{}, strings.Repeat("C", 10)},
+		// Contra quem trai, passa a trair quando a margem de incerteza da estimativa fica pequena
+		{"caça ao cervo contra quem trai", stagHunt, AlwaysDefect{}, "CCCCCCDDDD"},
+	}
+	for _, c := range cases {
+		game := NewGame(&MinimaxRegret{}, c.opponent, 10)
+		game.SetPayoff(c.m)
+		for round := 0; round < 10; round++ {
+			if err := game.PlayRound(round); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got := movesString(game.movesA); got != c.want {
+			t.Errorf("%s: %s, esperado %s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestBayesianPlayerIdentifiesTitForTat(t *testing.T) {
+	cases := []struct {
+		opponent Strategy
+		want     string
+	}{
+		{TitForTat{}, "Tit-for-Tat"},
+		{AlwaysCooperate{}, "Always Cooperate"},
+		{AlwaysDefect{}, "Always Defect"},
+	}
+	for _, c := range cases {
+		s := &BayesianPlayer{}
+		playMatch(t, s, c.opponent, 10, 1)
+		best := s.mostLikely()
+		if bayesianArchetypes[best] != c.want || s.posterior[best] < 0.95 {
+			t.Errorf("contra %s: tipo mais provável %s com %.3f (%s)", c.opponent.Name(), bayesianArchetypes[best], s.posterior[best], s.State())
+		}
+	}
+
+	// Identificado o Tit-for-Tat, coopera sempre depois da traição de teste
+	game := playMatch(t, &BayesianPlayer{}, TitForTat{}, 20, 1)
+	if moves := movesString(game.movesA[3:]); moves != strings.Repeat("C", 17) {
+		t.Errorf("contra Tit-for-Tat, depois da sondagem: %s", moves)
+	}
+}
+
+// defectOnce trai só na rodada round (contada a partir de 0) e coopera em todas as outras
+type defectOnce struct{ round int }
+
+func (s defectOnce) NextMove(round int, _ []Choice) Choice {
+	if round == s.round {
+		return Defect
+	}
+	return Cooperate
+}
+func (s defectOnce) Name() string { return "Defect Once" }
+
+func TestFriedmanAmnestyForgivesPersistentCooperator(t *testing.T) {
+	// O oponente trai na 3ª rodada e continua cooperando durante a punição: depois de 3 cooperações
+	// seguidas ele é anistiado e a cooperação mútua volta até o fim
+	game := playMatch(t, NewFriedmanAmnesty(3), defectOnce{2}, 15, 1)
+	if got, want := movesString(game.movesA), "CCCDDD"+strings.Repeat("C", 9); got != want {
+		t.Errorf("com anistia: %s, esperado %s", got, want)
+	}
+
+	// Quem trai a cada três rodadas nunca junta 3 cooperações seguidas e é punido para sempre
+	game = playMatch(t, NewFriedmanAmnesty(3), periodicDefector{3}, 15, 1)
+	if got, want := movesString(game.movesA), "CCC"+strings.Repeat("D", 12); got != want {
+		t.Errorf("sem anistia: %s, esperado %s", got, want)
+	}
+}
+
+// scripted joga a sequência de C e D dada, rodada a rodada, e coopera depois do fim dela
+type scripted string
+
+func (s scripted) NextMove(round int, _ []Choice) Choice {
+	if round < len(s) && s[round] == 'D' {
+		return Defect
+	}
+	return Cooperate
+}
+func (s scripted) Name() string { return "Scripted" }
+
+func TestEscalatingPunisherBurstsGrow(t *testing.T) {
+	// Traições do oponente nas rodadas 2, 6 e 11: rajadas de uma, duas e três traições logo depois,
+	// com a volta à cooperação ao fim de cada uma
+	opponent := scripted("CDCCCDCCCCDCCCCCC")
+	game := playMatch(t, &EscalatingPunisher{}, opponent, len(opponent), 1)
+	if got, want := movesString(game.movesA), "CCDCCCDDCCCDDDCCC"; got != want {
+		t.Errorf("Escalating Punisher jogou %s, esperado %s", got, want)
+	}
+
+	// Uma traição durante a rajada é contada, mas não estende a rajada atual: a traição da rodada 6
+	// cai na rajada de duas e a da rodada 10, a quarta, provoca uma rajada de quatro
+	opponent = scripted("CDCCDDCCCDCCCCCC")
+	game = playMatch(t, &EscalatingPunisher{}, opponent, len(opponent), 1)
+	if got, want := movesString(game.movesA), "CCDCCDDCCCDDDDCC"; got != want {
+		t.Errorf("com traições durante a rajada: %s, esperado %s", got, want)
+	}
+}
+
+func TestDeterministicChaosIsPinned(t *testing.T) {
+	// A sequência depende só do hash do número da rodada; fixá-la garante que ela não mude entre
+	// execuções nem entre plataformas
+	game := playMatch(t, DeterministicChaos{}, AlwaysDefect{}, 10, 1)
+	if got, want := movesString(game.movesA), "CCDDDCDCCD"; got != want {
+		t.Errorf("primeiras dez rodadas %s, esperado %s", got, want)
+	}
+	if again := playMatch(t, DeterministicChaos{}, AlwaysCooperate{}, 10, 2); movesString(again.movesA) != movesString(game.movesA) {
+		t.Error("a sequência mudou com o oponente ou a semente")
+	}
+}
+
+func TestRatioTargeterSteersTowardTarget(t *testing.T) {
+	cases := []struct {
+		name          string
+		own, opponent []Choice
+		want          Choice
+	}{
+		// 10 a 0: muito acima de 1,5 vez, coopera para o oponente pontuar
+		{"acima do alvo", []Choice{Defect, Defect}, []Choice{Cooperate, Cooperate}, Cooperate},
+		// 0 a 10: abaixo do alvo, trai para reduzir a diferença
+		{"abaixo do alvo", []Choice{Cooperate, Cooperate}, []Choice{Defect, Defect}, Defect},
+	}
+	for _, c := range cases {
+		s := NewRatioTargeter(1.5)
+		s.ownMoves = append(s.ownMoves, c.own...)
+		if got := s.NextMove(len(c.opponent), c.opponent); got != c.want {
+			t.Errorf("%s: jogou %s, esperado %s", c.name, moveCode(got), moveCode(c.want))
+		}
+	}
+
+	// Contra quem sempre coopera, o placar fica perto da proporção alvo
+	for _, target := range []float64{1.2, 1.5} {
+		game := playMatch(t, NewRatioTargeter(target), AlwaysCooperate{}, 300, 1)
+		if ratio := float64(game.scores[0]) / float64(game.scores[1]); math.Abs(ratio-target) > 0.05 {
+			t.Errorf("alvo %.1f: proporção final %.3f", target, ratio)
+		}
+	}
+}
+
+func TestOccasionalTesterProbesOnlyAfterStreaks(t *testing.T) {
+	forbidGlobalRNG(t)
+	const minStreak = 5
+
+	// Contra quem sempre perdoa, testa de vez em quando, sempre com uma traição isolada depois de
+	// pelo menos minStreak rodadas de cooperação mútua
+	game := playMatch(t, NewOccasionalTester(0.2, minStreak), AlwaysCooperate{}, 500, 1)
+	moves := movesString(game.movesA)
+	tests := strings.Count(moves, "D")
+	if tests < 10 {
+		t.Errorf("só %d testes em 500 rodadas contra Always Cooperate", tests)
+	}
+	for i, move := range game.movesA {
+		if move == Defect && (i < minStreak || strings.Contains(moves[i-minStreak:i], "D")) {
+			t.Errorf("teste na rodada %d sem %d rodadas de cooperação mútua antes: %s", i+1, minStreak, moves[max(0, i-minStreak):i+1])
+		}
+	}
+
+	// Contra quem nunca perdoa, testa uma única vez e depois só responde como Tit-for-Tat
+	s := NewOccasionalTester(0.5, minStreak)
+	game = playMatch(t, s, &Friedman{}, 200, 1)
+	unprovoked := 0
+	for i, move := range game.movesA {
+		if move == Defect && (i == 0 || game.movesB[i-1] == Cooperate) {
+			unprovoked++
+		}
+	}
+	if unprovoked != 1 || !s.gaveUp {
+		t.Errorf("contra Friedman: %d testes (desistiu: %v), esperado um só e a desistência", unprovoked, s.gaveUp)
+	}
+}
+
+func TestTournamentSettingsReflectPayoff(t *testing.T) {
+	m := PayoffMatrix{Reward: 4, Sucker: -1, Temptation: 6, Punishment: 2}
+	cfg := TournamentConfig{Rounds: 40, Seed: 8, Payoff: m}
+	settings := tournamentSettings(cfg, 3)
+	for _, want := range []string{
+		fmt.Sprintf(tr("settings_payoff"), 4, -1, 6, 2),
+		fmt.Sprintf(tr("settings_seed"), 8),
+		fmt.Sprintf(tr("settings_rounds"), 40, 3),
+	} {
+		if !strings.Contains(settings, want) {
+			t.Errorf("resumo sem %q:\n%s", want, settings)
+		}
+	}
+	// Sem matriz, o resumo mostra a matriz padrão
+	plain := tournamentSettings(TournamentConfig{Rounds: 40}, 1)
+	d := defaultPayoff
+	if !strings.Contains(plain, fmt.Sprintf(tr("settings_payoff"), d.Reward, d.Sucker, d.Temptation, d.Punishment)) {
+		t.Errorf("resumo da configuração padrão:\n%s", plain)
+	}
+}
+
+func TestTournamentPlaysWithConfiguredPayoff(t *testing.T) {
+	forbidGlobalRNG(t)
+	m := PayoffMatrix{Reward: 4, Sucker: -1, Temptation: 6, Punishment: 2}
+	strategies := []Strategy{AlwaysCooperate{}, AlwaysDefect{}}
+
+	// Cada jogo segue a matriz configurada
+	totals := playTournament(strategies, TournamentConfig{Rounds: 10, Seed: 1, Payoff: m}).TotalScores
+	if want := 2*10*m.Reward + 2*10*m.Sucker; totals["Always Cooperate"] != want {
+		t.Errorf("Always Cooperate fez %d pontos com a matriz configurada, esperado %d", totals["Always Cooperate"], want)
+	}
+}
+
+func TestTournamentPlaysWithNoise(t *testing.T) {
+	forbidGlobalRNG(t)
+	strategies := []Strategy{AlwaysCooperate{}, AlwaysDefect{}}
+
+	// Com ruído, Always Cooperate às vezes trai; o sorteio é reproduzível pela semente
+	cfg := TournamentConfig{Rounds: 200, Seed: 1, Noise: 0.1}
+	checkpoint := playTournament(strategies, cfg)
+	defections := checkpoint.Moves["Always Cooperate"] - checkpoint.Cooperations["Always Cooperate"]
+	if rate := float64(defections) / float64(checkpoint.Moves["Always Cooperate"]); rate < 0.07 || rate > 0.13 {
+		t.Errorf("Always Cooperate trocou %.3f das jogadas, esperado perto do ruído de 0,1", rate)
+	}
+	if again := playTournament(strategies, cfg); !reflect.DeepEqual(again.TotalScores, checkpoint.TotalScores) {
+		t.Errorf("o mesmo torneio com ruído deu %v e %v", checkpoint.TotalScores, again.TotalScores)
+	}
+
+	// O resumo da configuração informa o nível de ruído
+	settings := tournamentSettings(cfg, 1)
+	if !strings.Contains(settings, fmt.Sprintf(tr("settings_noise_level"), 10.0)) || strings.Contains(settings, tr("settings_noise")) {
+		t.Errorf("resumo da configuração com ruído:\n%s", settings)
+	}
+}
+
+func TestConfigResetRestoresEveryField(t *testing.T) {
+	edited := Config{
+		StrategyA: AlwaysDefect{}.Name(),
+		StrategyB: AlwaysCooperate{}.Name(),
+		Rounds:    50,
+		Payoff:    PayoffMatrix{Reward: 4, Sucker: -1, Temptation: 6, Punishment: 2},
+		Noise:     0.05,
+		Seed:      42,
+		Reps:      5,
+	}
+	// A configuração editada precisa diferir da padrão em todos os campos, inclusive nos que forem criados depois
+	defaults := reflect.ValueOf(defaultConfig())
+	for i := 0; i < defaults.NumField(); i++ {
+		if reflect.DeepEqual(defaults.Field(i).Interface(), reflect.ValueOf(edited).Field(i).Interface()) {
+			t.Fatalf("o campo %s da configuração editada é igual ao padrão", defaults.Type().Field(i).Name)
+		}
+	}
+
+	store := newConfigStore()
+	store.Set(edited)
+	store.Reset()
+	if store.current != defaultConfig() {
+		t.Errorf("depois de restaurar: %+v, esperado %+v", store.current, defaultConfig())
+	}
+	// Restaurar o padrão também pode ser desfeito, mas só uma vez
+	if !store.Undo() || store.current != edited {
+		t.Errorf("depois de desfazer: %+v, esperado %+v", store.current, edited)
+	}
+	if store.Undo() {
+		t.Error("desfez duas vezes com um só nível de desfazer")
+	}
+}
+
+func TestTidemanChieruzziKeepsConfiguredThreshold(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy TidemanChieruzzi
+		history  string
+		want     Choice
+	}{
+		// int(3 × 0,33) = 0: com a janela de 3, nenhuma traição recente fica abaixo do limite
+		{"janela 3, limite 0,33", NewTidemanChieruzzi(3, 0.33), "CCD", Defect},
+		{"janela 3, limite 0,67, uma traição", NewTidemanChieruzzi(3, 0.67), "CCD", Cooperate},
+		{"janela 3, limite 0,67, duas traições", NewTidemanChieruzzi(3, 0.67), "CDD", Defect},
+		{"a janela ignora traições antigas", NewTidemanChieruzzi(3, 0.67), "DDDCCD", Cooperate},
+		{"valor zero usa os padrões", TidemanChieruzzi{}, "CCCCD", Cooperate},
+		{"limite configurado de 0 nunca perdoa", NewTidemanChieruzzi(5, 0), "CCCCD", Defect},
+	}
+	for _, tt := range tests {
+		history := parseMoves(tt.history)
+		if got := tt.strategy.NextMove(len(history), history); got != tt.want {
+			t.Errorf("%s: contra %s jogou %s, esperado %s", tt.name, tt.history, moveCode(got), moveCode(tt.want))
+		}
+	}
+}
+
+func TestAlternatorAlternatesFromCooperation(t *testing.T) {
+	tests := []struct {
+		opponent Strategy
+		want     string
+	}{
+		{AlwaysCooperate{}, "CDCDCD"},
+		{AlwaysDefect{}, "CDCDCD"},
+		{TitForTat{}, "CDCDCD"},
+	}
+	for _, tt := range tests {
+		game := playMatch(t, Alternator{}, tt.opponent, 6, 1)
+		if got := movesString(game.movesA); got != tt.want {
+			t.Errorf("contra %s: %s, esperado %s", tt.opponent.Name(), got, tt.want)
+		}
+	}
+}
+
+func TestPhasedRebuildsDavis(t *testing.T) {
+	opponents := []func() Strategy{
+		func() Strategy { return AlwaysDefect{} },
+		func() Strategy { return Alternator{} },
+		func() Strategy { return scripted("CCCDDCCCCCDCDDCCDCCC") },
+		func() Strategy { return &Random{} },
+	}
+	for _, newOpponent := range opponents {
+		davis := playMatch(t, Davis{}, newOpponent(), 40, 3)
+		phased := playMatch(t, NewPhased(AlwaysCooperate{}, TitForTat{}, 10), newOpponent(), 40, 3)
+		if got, want := movesString(phased.movesA), movesString(davis.movesA); got != want {
+			t.Errorf("contra %s: Phased jogou %s, Davis jogou %s", newOpponent().Name(), got, want)
+		}
+	}
+}
+
+func TestDoublingWeightDoublesPairings(t *testing.T) {
+	strategies := []Strategy{TitForTat{}, AlwaysDefect{}, AlwaysCooperate{}}
+	// count conta os jogos entre i e j, em qualquer posição
+	count := func(pairings [][2]int, i, j int) int {
+		n := 0
+		for _, p := range pairings {
+			if (p[0] == i && p[1] == j) || (p[0] == j && p[1] == i) {
+				n++
+			}
+		}
+		return n
+	}
+	single := tournamentPairings(strategies, TournamentConfig{})
+	doubled := tournamentPairings(strategies, TournamentConfig{Weights: map[string]int{"Tit-for-Tat": 2}})
+	for j := 1; j < len(strategies); j++ {
+		if got, want := count(doubled, 0, j), 2*count(single, 0, j); got != want {
+			t.Errorf("Tit-for-Tat contra %s: %d jogos com peso 2, esperado %d", strategies[j].Name(), got, want)
+		}
+		// Os confrontos sem Tit-for-Tat não mudam
+		if got, want := count(doubled, j, j), count(single, j, j); got != want {
+			t.Errorf("%s contra si mesma: %d jogos com peso 2 para Tit-for-Tat, esperado %d", strategies[j].Name(), got, want)
+		}
+	}
+	// Contra si mesma, cada cópia enfrenta as duas: o dobro de cópias dá o quádruplo de jogos
+	if got, want := count(doubled, 0, 0), 4*count(single, 0, 0); got != want {
+		t.Errorf("Tit-for-Tat contra si mesma: %d jogos com peso 2, esperado %d", got, want)
+	}
+}
+
+func TestFormatOutcome(t *testing.T) {
+	tests := []struct {
+		name           string
+		scoreA, scoreB int
+		want           []string // Linhas que o resultado precisa conter
+		absent         []string // Linhas que ele não pode conter
+	}{
+		{"A vence", 150, 100, []string{
+			fmt.Sprintf(tr("winner"), "Alpha"),
+			fmt.Sprintf(tr("margin_lead"), 50, 50.0),
+		}, []string{fmt.Sprintf(tr("winner"), "Beta")}},
+		{"B vence", 30, 120, []string{
+			fmt.Sprintf(tr("winner"), "Beta"),
+			fmt.Sprintf(tr("margin_lead"), 90, 300.0),
+		}, []string{fmt.Sprintf(tr("winner"), "Alpha")}},
+		{"perdedor sem pontos", 0, 40, []string{
+			fmt.Sprintf(tr("winner"), "Beta"),
+			fmt.Sprintf(tr("margin"), 40),
+		}, nil},
+		{"empate", 77, 77, []string{fmt.Sprintf(tr("tie"), 77)}, []string{
+			fmt.Sprintf(tr("winner"), "Alpha"),
+			fmt.Sprintf(tr("winner"), "Beta"),
+		}},
+		{"empate em 0 a 0", 0, 0, []string{fmt.Sprintf(tr("tie"), 0)}, []string{
+			fmt.Sprintf(tr("winner"), "Alpha"),
+			fmt.Sprintf(tr("winner"), "Beta"),
+		}},
+	}
+	for _, tt := range tests {
+		got := formatOutcome("Alpha", "Beta", tt.scoreA, tt.scoreB)
+		want := append([]string{
+			fmt.Sprintf(tr("points_line"), "Alpha", tt.scoreA),
+			fmt.Sprintf(tr("points_line"), "Beta", tt.scoreB),
+		}, tt.want...)
+		for _, line := range want {
+			if !strings.Contains(got, line) {
+				t.Errorf("%s: sem %q:\n%s", tt.name, line, got)
+			}
+		}
+		for _, line := range tt.absent {
+			if strings.Contains(got, line) {
+				t.Errorf("%s: com %q:\n%s", tt.name, line, got)
+			}
+		}
+	}
+}
+
+func TestFrequencyModelerCooperatesWithTitForTat(t *testing.T) {
+	modeler := &FrequencyModeler{}
+	game := playMatch(t, modeler, TitForTat{}, 50, 1)
+	if got := movesString(game.movesA); got != strings.Repeat("C", 50) {
+		t.Errorf("contra Tit-for-Tat: %s, esperado só cooperação", got)
+	}
+	// Tit-for-Tat sempre respondeu à cooperação com cooperação, e a traição nunca foi testada
+	if got := modeler.responses; got[Cooperate][Cooperate] != 48 || got[Cooperate][Defect] != 0 || got[Defect] != [2]int{} {
+		t.Errorf("respostas aprendidas: %v, esperado 48 cooperações após cooperar e nada mais", got)
+	}
+}
+
+func TestEstimateWork(t *testing.T) {
+	tests := []struct {
+		n, reps, rounds int
+		want            int64
+	}{
+		{0, 1, 200, 0},
+		{1, 1, 200, 200},
+		{3, 2, 100, 1_800},
+		// Sem estouro de int32: 1000 participantes, 10 repetições e 1000 rodadas
+		{1000, 10, 1000, 10_000_000_000},
+	}
+	for _, tt := range tests {
+		if got := estimateWork(tt.n, tt.reps, tt.rounds); got != tt.want {
+			t.Errorf("estimateWork(%d, %d, %d) = %d, esperado %d", tt.n, tt.reps, tt.rounds, got, tt.want)
+		}
+	}
+	// O torneio padrão com todas as estratégias registradas não pede confirmação
+	if work := estimateWork(len(newStrategies()), 1, 200); work > heavyTournamentRounds {
+		t.Errorf("torneio padrão estimado em %d rodadas, acima do limite de %d", work, heavyTournamentRounds)
+	}
+}
+
+func TestForgivingPavlov(t *testing.T) {
+	tests := []struct {
+		opponent Strategy
+		want     string
+	}{
+		{TitForTat{}, "CCCCCCCCCC"},
+		// Explorada, passa a trair; na traição mútua, perdoa e tenta cooperar de novo
+		{AlwaysDefect{}, "CDCDCDCDCD"},
+		// Mantém a traição enquanto ela pontua bem, contra quem coopera
+		{scripted("CDCCCDDCCC"), "CCDDDDCDDD"},
+	}
+	for _, tt := range tests {
+		game := playMatch(t, &ForgivingPavlov{}, tt.opponent, 10, 1)
+		if got := movesString(game.movesA); got != tt.want {
+			t.Errorf("contra %s: %s, esperado %s", tt.opponent.Name(), got, tt.want)
+		}
+	}
+}
+
+func TestHumanStrategyPlaysMovesFromChannel(t *testing.T) {
+	// Como os botões da interface, outra goroutine envia uma jogada por rodada
+	moves := make(chan Choice)
+	go func() {
+		for _, move := range parseMoves("CDDCD") {
+			moves <- move
+		}
+	}()
+	game := playMatch(t, NewHumanStrategy(moves), TitForTat{}, 5, 1)
+	if got := movesString(game.movesA); got != "CDDCD" {
+		t.Errorf("jogadas do humano: %s, esperado CDDCD", got)
+	}
+	if got := movesString(game.movesB); got != "CCDDC" {
+		t.Errorf("respostas de Tit-for-Tat: %s, esperado CCDDC", got)
+	}
+}
+
+func TestFindFirst(t *testing.T) {
+	// Scripted contra Tit-for-Tat: as traições de A nunca coincidem com a resposta de B
+	movesA, movesB := parseMoves("CCDCD"), parseMoves("CCCDC")
+	history := make([]roundData, len(movesA))
+	for i := range history {
+		history[i] = roundData{round: i + 1, moveA: movesA[i], moveB: movesB[i]}
+	}
+	mutualDefection := func(d roundData) bool { return d.moveA == Defect && d.moveB == Defect }
+	tests := []struct {
+		name    string
+		history []roundData
+		pred    func(roundData) bool
+		want    int
+	}{
+		{"primeira traição de A", history, func(d roundData) bool { return d.moveA == Defect }, 2},
+		{"primeira traição de B", history, func(d roundData) bool { return d.moveB == Defect }, 3},
+		{"traição mútua nunca ocorreu", history, mutualDefection, -1},
+		{"histórico vazio", nil, func(roundData) bool { return true }, -1},
+	}
+	for _, tt := range tests {
+		if got := findFirst(tt.history, tt.pred); got != tt.want {
+			t.Errorf("%s: %d, esperado %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLaggedTitForTatCopiesTwoRoundsBack(t *testing.T) {
+	opponent := "CDDCCDCCCD"
+	game := playMatch(t, LaggedTitForTat{}, scripted(opponent), len(opponent), 1)
+	// Coopera nas duas primeiras rodadas e depois repete o oponente com duas rodadas de atraso
+	if got, want := movesString(game.movesA), "CC"+opponent[:len(opponent)-2]; got != want {
+		t.Errorf("contra %s: %s, esperado %s", opponent, got, want)
+	}
+}
+
+func TestTieBreakPolicies(t *testing.T) {
+	strategies := []Strategy{TitForTat{}, AlwaysDefect{}, AlwaysCooperate{}}
+	// Três estratégias empatadas em 100 pontos, com taxas de cooperação e confrontos diretos diferentes
+	tied := func(tieBreak TieBreak) *TournamentCheckpoint {
+		c := newTournamentCheckpoint(strategies, TournamentConfig{Rounds: 10, TieBreak: tieBreak})
+		for i, cooperations := range []int{60, 0, 100} {
+			name := strategies[i].Name()
+			c.TotalScores[name], c.Cooperations[name], c.Moves[name] = 100, cooperations, 100
+		}
+		// Always Defect ganha os dois confrontos diretos; Tit-for-Tat e Always Cooperate empatam
+		c.Matrix = [][]int{
+			{0, 20, 25},
+			{30, 0, 50},
+			{25, 0, 0},
+		}
+		return c
+	}
+	tests := []struct {
+		tieBreak TieBreak
+		want     []string
+	}{
+		{TieBreakCooperation, []string{"Always Cooperate", "Tit-for-Tat", "Always Defect"}},
+		{TieBreakHeadToHead, []string{"Always Defect", "Always Cooperate", "Tit-for-Tat"}},
+		{TieBreakAlphabetical, []string{"Always Cooperate", "Always Defect", "Tit-for-Tat"}},
+	}
+	for _, tt := range tests {
+		results, _ := tied(tt.tieBreak).results(strategies)
+		if got := resultNames(results); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("desempate %s: %v, esperado %v", tieBreakLabels()[tt.tieBreak], got, tt.want)
+		}
+		for i, result := range results {
+			if result.rank != i+1 {
+				t.Errorf("desempate %s: %s na posição %d com rank %d", tieBreakLabels()[tt.tieBreak], result.name, i+1, result.rank)
+			}
+		}
+	}
+}
+
+func TestFairnessEnforcerEvensTheScore(t *testing.T) {
+	tests := []struct {
+		opponent Strategy
+		want     string
+	}{
+		{TitForTat{}, "CCCCCC"},
+		// Sempre atrás de Always Defect, nunca volta a cooperar
+		{AlwaysDefect{}, "CDDDDD"},
+		// Explorada na primeira rodada, trai uma vez para empatar e volta a cooperar
+		{scripted("DCCCCC"), "CDCCCC"},
+	}
+	for _, tt := range tests {
+		game := playMatch(t, &FairnessEnforcer{payoff: defaultPayoff}, tt.opponent, 6, 1)
+		if got := movesString(game.movesA); got != tt.want {
+			t.Errorf("contra %s: %s, esperado %s", tt.opponent.Name(), got, tt.want)
+		}
+	}
+	// Contra quem trai uma vez e depois coopera, o placar termina empatado
+	if game := playMatch(t, &FairnessEnforcer{payoff: defaultPayoff}, scripted("DCCCCC"), 6, 1); game.scores[0] != game.scores[1] {
+		t.Errorf("placar final %d a %d, esperado empate", game.scores[0], game.scores[1])
+	}
+}
+
+func TestEndgamerDefectsOnlyInFinalRounds(t *testing.T) {
+	const rounds, finalRounds = 30, 5
+	for seed := int64(1); seed <= 50; seed++ {
+		game := playMatch(t, NewEndgamer(finalRounds, 1), AlwaysCooperate{}, rounds, seed)
+		moves := movesString(game.movesA)
+		if first := strings.IndexByte(moves, 'D'); first >= 0 && first < rounds-finalRounds {
+			t.Errorf("semente %d: traiu na rodada %d, antes das %d últimas: %s", seed, first+1, finalRounds, moves)
+		}
+		// Com maxDefect = 1, a traição na última rodada é certa
+		if moves[rounds-1] != 'D' {
+			t.Errorf("semente %d: cooperou na última rodada: %s", seed, moves)
+		}
+	}
+
+	// Sem conhecer o total de rodadas, é só Tit-for-Tat
+	endgamer := NewEndgamer(finalRounds, 1)
+	endgamer.SetRand(rand.New(rand.NewSource(1)))
+	history := parseMoves(strings.Repeat("C", rounds))
+	for round := 0; round < rounds; round++ {
+		if endgamer.NextMove(round, history[:round]) != Cooperate {
+			t.Fatalf("sem horizonte, traiu na rodada %d", round+1)
+		}
+	}
+}
+
+func TestPeaceOfferingEscapesDefectionSpiral(t *testing.T) {
+	// O oponente trai nas três primeiras rodadas e depois só trai após duas traições seguidas
+	opponent := func() Strategy { return NewPhased(AlwaysDefect{}, titForTwoTats{}, 3) }
+	const rounds, tail = 30, 10
+
+	// Tit-for-Tat responde às traições e fica presa na traição mútua
+	tft := playMatch(t, TitForTat{}, opponent(), rounds, 1)
+	if got := movesString(tft.movesA[rounds-tail:]) + movesString(tft.movesB[rounds-tail:]); got != strings.Repeat("D", 2*tail) {
+		t.Errorf("Tit-for-Tat saiu do ciclo de traições: %s / %s", movesString(tft.movesA), movesString(tft.movesB))
+	}
+
+	// Peace Offering coopera depois de duas traições mútuas e os dois voltam a cooperar
+	peace := playMatch(t, NewPeaceOffering(2), opponent(), rounds, 1)
+	if got := movesString(peace.movesA[rounds-tail:]) + movesString(peace.movesB[rounds-tail:]); got != strings.Repeat("C", 2*tail) {
+		t.Errorf("Peace Offering não saiu do ciclo de traições: %s / %s", movesString(peace.movesA), movesString(peace.movesB))
+	}
+	if got := movesString(peace.movesA[:6]); got != "CDDCDC" {
+		t.Errorf("primeiras jogadas de Peace Offering: %s, esperado CDDCDC", got)
+	}
+}
+
+func TestWeightedMajorityFavorsRecentMoves(t *testing.T) {
+	tests := []struct {
+		decay   float64
+		history string
+		want    Choice
+	}{
+		// Maioria simples: quatro cooperações contra duas traições
+		{1, "CCCCDD", Cooperate},
+		{0.5, "CCCCDD", Defect},
+		{1, "CCCD", Cooperate},
+		{0.1, "CCCD", Defect},
+		{1, "DDC", Defect},
+		{0.1, "DDC", Cooperate},
+	}
+	for _, tt := range tests {
+		history := parseMoves(tt.history)
+		if got := NewWeightedMajority(tt.decay).NextMove(len(history), history); got != tt.want {
+			t.Errorf("decay %.1f contra %s: %s, esperado %s", tt.decay, tt.history, moveCode(got), moveCode(tt.want))
+		}
+	}
+
+	// A soma incremental, rodada a rodada, dá o mesmo que recalcular com o histórico inteiro
+	opponent := "CCDDDCCDCDDDCCCC"
+	game := playMatch(t, NewWeightedMajority(0.7), scripted(opponent), len(opponent), 1)
+	for round := 1; round < len(opponent); round++ {
+		history := parseMoves(opponent[:round])
+		if want := NewWeightedMajority(0.7).NextMove(round, history); game.movesA[round] != want {
+			t.Errorf("rodada %d: jogou %s de forma incremental e %s recalculando", round+1, moveCode(game.movesA[round]), moveCode(want))
+		}
+	}
+}
+
+func TestRoundPointsSumToFinalScore(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Game)
+	}{
+		{"matriz padrão", func(*Game) {}},
+		{"bônus e penalidade", func(g *Game) {
+			g.SetCooperationBonus(2)
+			g.SetDefectionEscalation(1)
+		}},
+		{"pontuação inicial", func(g *Game) { g.SetStartingScores(15, -4) }},
+	}
+	for _, tt := range tests {
+		game := NewGame(&Joss{}, &Shubik{}, 60)
+		game.SeedStrategies(9)
+		tt.setup(game)
+		var history []roundData
+		for round := 0; round < 60; round++ {
+			if err := game.PlayRound(round); err != nil {
+				t.Fatal(err)
+			}
+			history = appendRound(history, game)
+		}
+		sumA, sumB := game.handicap[0], game.handicap[1]
+		for _, data := range history {
+			sumA += data.pointsA
+			sumB += data.pointsB
+		}
+		if sumA != game.scores[0] || sumB != game.scores[1] {
+			t.Errorf("%s: pontos por rodada somam %d e %d, placar final %d e %d", tt.name, sumA, sumB, game.scores[0], game.scores[1])
+		}
+	}
+}
+
+func TestNegotiatorPaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		opponent Strategy
+		want     string
+		state    int
+	}{
+		{"o oponente retribui", TitForTat{}, "CCCCCCCC", negotiatorCommitted},
+		{"uma traição: aviso e nova oferta", scripted("CDCCCCCC"), "CCDCCCCC", negotiatorCommitted},
+		{"o aviso é respondido com traição", scripted("CCDDCCCC"), "CCCDCCCC", negotiatorCommitted},
+		{"ignorada duas vezes, escala", AlwaysDefect{}, "CDCDDDDD", negotiatorHostile},
+		{"duas traições distantes também escalam", scripted("CDCCCDCC"), "CCDCCCDD", negotiatorHostile},
+	}
+	for _, tt := range tests {
+		negotiator := &Negotiator{}
+		game := playMatch(t, negotiator, tt.opponent, 8, 1)
+		if got := movesString(game.movesA); got != tt.want || negotiator.state != tt.state {
+			t.Errorf("%s: %s no estado %d, esperado %s no estado %d", tt.name, got, negotiator.state, tt.want, tt.state)
+		}
+	}
+}
+
+func TestQLearnerLearns(t *testing.T) {
+	// Contra quem sempre trai ou sempre coopera, trair é a melhor jogada; sem exploração, depois
+	// de experimentar as duas jogadas o Q-Learner deve se fixar na traição
+	for _, opponent := range []Strategy{AlwaysDefect{}, AlwaysCooperate{}} {
+		game := playMatch(t, NewQLearner(1, 0.5, 0), opponent, 300, 1)
+		if got := movesString(game.movesA[200:]); strings.Contains(got, "C") {
+			t.Errorf("contra %s ainda coopera no fim: %s", opponent.Name(), got)
+		}
+	}
+
+	// Uma atualização: cooperou no estado 0 e levou uma traição (0 pontos); o novo estado (1)
+	// ainda vale o otimista 10/(1 - 0,9) = 100, então o alvo é 0 + 0,9·100 = 90 e o valor vai a
+	// 100 + 0,5·(90 - 100)
+	s := NewQLearner(1, 0.5, 0)
+	s.NextMove(0, nil)
+	s.NextMove(1, []Choice{Defect})
+	if got := s.q[0][Cooperate]; math.Abs(got-95) > 1e-9 {
+		t.Errorf("Q(0, C) = %v, esperado 95", got)
+	}
+	if got := s.q[0][Defect]; got != 100 {
+		t.Errorf("Q(0, D) = %v, esperado o otimista 100", got)
+	}
+	// Um novo jogo recomeça com a tabela otimista
+	s.NextMove(0, nil)
+	if got := s.q[0][Cooperate]; got != 100 {
+		t.Errorf("Q(0, C) = %v após um novo jogo, esperado 100", got)
+	}
+}
+
+func TestStimulusResponse(t *testing.T) {
+	// Sem observações as duas estimativas são 0,5 e trair rende mais; contra Tit-for-Tat as
+	// traições são respondidas e ela volta a cooperar de vez
+	s := &StimulusResponse{payoff: defaultPayoff}
+	game := playMatch(t, s, &TitForTat{}, 40, 1)
+	if got, want := movesString(game.movesA), "CDD"+strings.Repeat("C", 37); got != want {
+		t.Errorf("contra Tit-for-Tat jogou %s, esperado %s", got, want)
+	}
+	// Estímulos nas rodadas 0 a 37: 36 cooperações, todas respondidas com cooperação, e 2 traições,
+	// ambas respondidas com traição (com a correção de Laplace)
+	if got, want := s.cooperationAfter(Cooperate), 37.0/38; math.Abs(got-want) > 1e-9 {
+		t.Errorf("p(C|C) = %v, esperado %v", got, want)
+	}
+	if got, want := s.cooperationAfter(Defect), 1.0/4; math.Abs(got-want) > 1e-9 {
+		t.Errorf("p(C|D) = %v, esperado %v", got, want)
+	}
+
+	// Quem coopera sempre é explorado desde a segunda rodada
+	game = playMatch(t, &StimulusResponse{payoff: defaultPayoff}, AlwaysCooperate{}, 40, 1)
+	if got, want := movesString(game.movesA), "C"+strings.Repeat("D", 39); got != want {
+		t.Errorf("contra Always Cooperate jogou %s, esperado %s", got, want)
+	}
+}
+
+func TestStrategyDrawsAreIndependent(t *testing.T) {
+	forbidGlobalRNG(t)
+
+	// B não reage às jogadas de A, então só os sorteios de A poderiam mudar a sequência de B: Joss
+	// sorteia só quando o oponente coopera, Random a cada rodada e Always Defect nunca
+	var want string
+	for i, a := range []Strategy{&Joss{}, &Random{}, &biasedRandom{p: 0.9}, AlwaysDefect{}} {
+		game := playMatch(t, a, &biasedRandom{p: 0.5}, 200, 11)
+		got := movesString(game.movesB)
+		if i == 0 {
+			want = got
+		} else if got != want {
+			t.Errorf("contra %s a sequência de B mudou:\n%s\n%s", a.Name(), got, want)
+		}
+	}
+
+	// Os dois lados recebem geradores diferentes, e a semente do jogo muda as sequências
+	game := playMatch(t, &Random{}, &Random{}, 200, 11)
+	if movesString(game.movesA) == movesString(game.movesB) {
+		t.Error("os dois Random jogaram a mesma sequência")
+	}
+	if other := playMatch(t, &Joss{}, &biasedRandom{p: 0.5}, 200, 12); movesString(other.movesB) == want {
+		t.Error("outra semente repetiu a sequência de B")
+	}
+}
+
+func TestRandomMatchup(t *testing.T) {
+	for seed := int64(0); seed < 500; seed++ {
+		matchup := randomMatchup(rand.New(rand.NewSource(seed)))
+		if newStrategy(matchup.nameA) == nil || newStrategy(matchup.nameB) == nil {
+			t.Fatalf("semente %d: estratégia não registrada em %+v", seed, matchup)
+		}
+		if matchup.nameA == matchup.nameB {
+			t.Errorf("semente %d: a mesma estratégia dos dois lados (%s)", seed, matchup.nameA)
+		}
+		if matchup.rounds < matchupMinRounds || matchup.rounds > matchupMaxRounds {
+			t.Errorf("semente %d: %d rodadas, fora de [%d, %d]", seed, matchup.rounds, matchupMinRounds, matchupMaxRounds)
+		}
+		// A semente informada reproduz a partida
+		if again := randomMatchup(rand.New(rand.NewSource(seed))); again != matchup {
+			t.Errorf("semente %d: %+v e depois %+v", seed, matchup, again)
+		}
+	}
+
+	// Com uma só estratégia registrada, ela joga contra si mesma
+	defer func(registry []func() Strategy) { strategyRegistry = registry }(strategyRegistry)
+	strategyRegistry = []func() Strategy{func() Strategy { return &TitForTat{} }}
+	if matchup := randomMatchup(rand.New(rand.NewSource(1))); matchup.nameA != "Tit-for-Tat" || matchup.nameB != "Tit-for-Tat" {
+		t.Errorf("partida %+v com só Tit-for-Tat registrada", matchup)
+	}
+}
+
+func TestLoopExploiterAgainstTitForTwoTats(t *testing.T) {
+	// Depois da sondagem, Tit-for-Two-Tats só é explicado pelo padrão two-echo, e o LoopExploiter
+	// trai em rodadas alternadas sem nunca trair duas vezes seguidas
+	s := &LoopExploiter{}
+	game := playMatch(t, s, titForTwoTats{}, 40, 1)
+	if got, want := movesString(game.movesA), movesString(loopProbe)+strings.Repeat("DC", 17); got != want {
+		t.Errorf("jogou %s, esperado %s", got, want)
+	}
+	// A única retaliação é a resposta à traição dupla da sondagem
+	if got, want := movesString(game.movesB), "CCCCCD"+strings.Repeat("C", 34); got != want {
+		t.Errorf("Tit-for-Two-Tats jogou %s, esperado %s", got, want)
+	}
+	if s.pattern != "two-echo" {
+		t.Errorf("padrão %q, esperado two-echo", s.pattern)
+	}
+
+	// Contra Tit-for-Tat, explorar não compensa e ele volta à cooperação mútua
+	s = &LoopExploiter{}
+	game = playMatch(t, s, &TitForTat{}, 40, 1)
+	if got := movesString(game.movesA[len(loopProbe):]); strings.Contains(got, "D") {
+		t.Errorf("contra Tit-for-Tat traiu depois da sondagem: %s", got)
+	}
+	if s.pattern != "echo" {
+		t.Errorf("padrão %q contra Tit-for-Tat, esperado echo", s.pattern)
+	}
+}
+
+func TestPerRoundScore(t *testing.T) {
+	cases := []struct {
+		total, rounds, opponents int
+		want                     float64
+	}{
+		{600, 200, 1, 3},
+		{2100, 100, 7, 3},
+		{150, 1, 4, 37.5},
+		{0, 100, 3, 0},
+		{-40, 10, 2, -2},
+		// Sem rodadas ou sem jogos não há o que normalizar
+		{500, 0, 3, 0},
+		{500, 100, 0, 0},
+		{500, -1, 3, 0},
+	}
+	for _, c := range cases {
+		if got := perRoundScore(c.total, c.rounds, c.opponents); got != c.want {
+			t.Errorf("perRoundScore(%d, %d, %d) = %v, esperado %v", c.total, c.rounds, c.opponents, got, c.want)
+		}
+	}
+
+	// Em um torneio, a pontuação por rodada de quem coopera com quem sempre coopera é a recompensa
+	strategies := []Strategy{AlwaysCooperate{}, TitForTat{}}
+	results, _ := playTournament(strategies, TournamentConfig{Rounds: 50, Seed: 1}).results(strategies)
+	for _, result := range results {
+		if got := perRoundScore(result.score, 50, result.games); got != float64(defaultPayoff.Reward) {
+			t.Errorf("%s: %v pontos por rodada, esperado %d", result.name, got, defaultPayoff.Reward)
+		}
+	}
+}
+
+func TestMixedStrategy(t *testing.T) {
+	forbidGlobalRNG(t)
+
+	// Nos extremos a mistura é só uma das estratégias
+	game := playMatch(t, NewMixedStrategy(AlwaysCooperate{}, AlwaysDefect{}, 0), AlwaysCooperate{}, 200, 1)
+	if rate := cooperationRate(game.movesA); rate != 0 {
+		t.Errorf("com p = 0 cooperou %.3f das vezes, esperado só Always Defect", rate)
+	}
+	game = playMatch(t, NewMixedStrategy(AlwaysCooperate{}, AlwaysDefect{}, 1), AlwaysCooperate{}, 200, 1)
+	if rate := cooperationRate(game.movesA); rate != 1 {
+		t.Errorf("com p = 1 cooperou %.3f das vezes, esperado só Always Cooperate", rate)
+	}
+
+	// Com p = 0,3 a fração de rodadas delegadas à primeira fica perto de 0,3 (o desvio padrão em
+	// 10000 rodadas é cerca de 0,005)
+	game = playMatch(t, NewMixedStrategy(AlwaysCooperate{}, AlwaysDefect{}, 0.3), AlwaysCooperate{}, 10000, 2)
+	if rate := cooperationRate(game.movesA); rate < 0.28 || rate > 0.32 {
+		t.Errorf("com p = 0,3 cooperou %.3f das vezes", rate)
+	}
+}
+
+// slippingTFT joga Tit-for-Tat, mas trai uma vez por engano na rodada round
+type slippingTFT struct{ round int }
+
+func (s slippingTFT) NextMove(round int, opponentMoves []Choice) Choice {
+	if round == s.round {
+		return Defect
+	}
+	return TitForTat{}.NextMove(round, opponentMoves)
+}
+func (s slippingTFT) Name() string { return "Slipping TFT" }
+
+func TestApologizerEndsRetaliationEcho(t *testing.T) {
+	// Contra Tit-for-Tat, o engano vira um eco de traições alternadas até o fim
+	game := playMatch(t, TitForTat{}, slippingTFT{2}, 12, 1)
+	if got, want := movesString(game.movesA), "CCC"+strings.Repeat("DC", 4)+"D"; got != want {
+		t.Errorf("Tit-for-Tat jogou %s, esperado %s", got, want)
+	}
+
+	// O Apologizer retalia (rodada 3), explora sem querer quem voltou a cooperar, pede desculpas
+	// (rodada 4) e não retalia a resposta merecida (rodada 5): a cooperação mútua volta
+	s := &Apologizer{}
+	game = playMatch(t, s, slippingTFT{2}, 12, 1)
+	if got, want := movesString(game.movesA), "CCCD"+strings.Repeat("C", 8); got != want {
+		t.Errorf("Apologizer jogou %s, esperado %s", got, want)
+	}
+	if got, want := movesString(game.movesB), "CCDCD"+strings.Repeat("C", 7); got != want {
+		t.Errorf("o oponente jogou %s, esperado %s", got, want)
+	}
+	if s.forgiving {
+		t.Error("ainda perdoando depois de a cooperação voltar")
+	}
+
+	// Uma traição que não é resposta a uma exploração continua sendo retaliada
+	game = playMatch(t, &Apologizer{}, scripted("CCDD"), 6, 1)
+	if got := movesString(game.movesA); got != "CCCDDC" {
+		t.Errorf("contra traições sem motivo jogou %s, esperado CCCDDC", got)
+	}
+}
+
+func TestHardenerForgivesLessAfterExploitation(t *testing.T) {
+	forbidGlobalRNG(t)
+
+	s := &Hardener{}
+	for k, want := range []float64{0.6, 0.42, 0.294} {
+		s.exploitations = k
+		if got := s.forgiveness(); math.Abs(got-want) > 1e-9 {
+			t.Errorf("perdão após %d explorações = %v, esperado %v", k, got, want)
+		}
+	}
+
+	// Contra Always Defect, cada perdão é uma nova exploração, e o perdão rareia ao longo do jogo
+	early, late := 0, 0
+	for seed := int64(0); seed < 50; seed++ {
+		s := &Hardener{}
+		game := playMatch(t, s, AlwaysDefect{}, 200, seed)
+		if got := strings.Count(movesString(game.movesA[:199]), "C"); got != s.exploitations {
+			t.Fatalf("semente %d: %d cooperações e %d explorações", seed, got, s.exploitations)
+		}
+		early += strings.Count(movesString(game.movesA[1:21]), "C")
+		late += strings.Count(movesString(game.movesA[100:200]), "C")
+	}
+	// Frações de cooperação nas rodadas 1 a 20 e 100 a 199
+	if earlyRate, lateRate := float64(early)/(50*20), float64(late)/(50*100); earlyRate < 5*lateRate {
+		t.Errorf("cooperação no início %.3f e no fim %.3f: o perdão não diminuiu", earlyRate, lateRate)
+	}
+
+	// Quem coopera nunca é retaliado
+	s = &Hardener{}
+	game := playMatch(t, s, AlwaysCooperate{}, 50, 1)
+	if got := movesString(game.movesA); strings.Contains(got, "D") || s.exploitations != 0 {
+		t.Errorf("contra Always Cooperate jogou %s com %d explorações", got, s.exploitations)
+	}
+}
+
+func TestMaximinFollowsPayoff(t *testing.T) {
+	cases := []struct {
+		name   string
+		payoff PayoffMatrix
+		want   Choice
+	}{
+		{"dilema do prisioneiro", defaultPayoff, Defect},
+		// Pior caso de cooperar (S = 2) melhor que o de trair (P = 1)
+		{"otário protegido", PayoffMatrix{Reward: 7, Sucker: 2, Temptation: 5, Punishment: 1}, Cooperate},
+		{"empate nos piores casos", PayoffMatrix{Reward: 3, Sucker: 1, Temptation: 5, Punishment: 1}, Cooperate},
+		// Aqui o pior caso de cooperar é R, menor que S
+		{"recompensa baixa", PayoffMatrix{Reward: 1, Sucker: 4, Temptation: 6, Punishment: 2}, Defect},
+	}
+	for _, c := range cases {
+		// A matriz chega à estratégia pelo jogo, e a escolha não depende do oponente
+		game := NewGame(&Maximin{}, scripted("CDDC"), 4)
+		game.SetPayoff(c.payoff)
+		for round := 0; round < 4; round++ {
+			if err := game.PlayRound(round); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got, want := movesString(game.movesA), strings.Repeat(moveCode(c.want), 4); got != want {
+			t.Errorf("%s: jogou %s, esperado %s", c.name, got, want)
+		}
+	}
+}
+
+func TestPrimeCooperator(t *testing.T) {
+	// Rodadas 1 a 30 (contando a partir de 1): coopera em 2, 3, 5, 7, 11, 13, 17, 19, 23 e 29
+	want := "DCCDCDCDDDCDCDDDCDCDDDCDDDDDCD"
+	for _, opponent := range []Strategy{AlwaysCooperate{}, AlwaysDefect{}, TitForTat{}} {
+		game := playMatch(t, PrimeCooperator{}, opponent, len(want), 1)
+		if got := movesString(game.movesA); got != want {
+			t.Errorf("contra %s jogou %s, esperado %s", opponent.Name(), got, want)
+		}
+	}
+
+	for n, want := range map[int]bool{-7: false, 0: false, 1: false, 2: true, 9: false, 49: false, 97: true, 7919: true, 7921: false} {
+		if isPrime(n) != want {
+			t.Errorf("isPrime(%d) = %t, esperado %t", n, !want, want)
+		}
+	}
+}
+
+func TestOpponentLabel(t *testing.T) {
+	if got := opponentLabel("Tit-for-Tat", true); got != "Tit-for-Tat" {
+		t.Errorf("oponente revelado exibido como %q", got)
+	}
+	// Escondido, o nome não aparece em nenhum idioma
+	defer func(previous string) { language = previous }(language)
+	for _, lang := range languages {
+		language = lang
+		got := opponentLabel("Tit-for-Tat", false)
+		if got != tr("hidden_opponent") || got == "hidden_opponent" || strings.Contains(got, "Tit-for-Tat") {
+			t.Errorf("%s: oponente escondido exibido como %q", lang, got)
+		}
+	}
+}
+
+func TestChameleonImitatesGrofman(t *testing.T) {
+	// Nas 10 primeiras rodadas joga Tit-for-Tat e ecoa as traições de Grofman (rodadas 0 e 5);
+	// reconhecido o oponente, passa a trair nas rodadas múltiplas de 5, como ele
+	s := &Chameleon{}
+	game := playMatch(t, s, Grofman{}, 30, 1)
+	if got, want := movesString(game.movesA), "CDCCCCDCCC"+strings.Repeat("DCCCC", 4); got != want {
+		t.Errorf("jogou %s, esperado %s", got, want)
+	}
+	if got := s.State(); got != "imitating=Grofman" {
+		t.Errorf("estado %q, esperado imitating=Grofman", got)
+	}
+
+	// Um oponente que sorteia não é reconhecido, e ele continua com Tit-for-Tat
+	s = &Chameleon{}
+	game = playMatch(t, s, &biasedRandom{p: 0.5}, 30, 1)
+	for round := 1; round < 30; round++ {
+		if game.movesA[round] != game.movesB[round-1] {
+			t.Fatalf("rodada %d: não repetiu a jogada anterior do oponente (%s contra %s)",
+				round, movesString(game.movesA), movesString(game.movesB))
+		}
+	}
+	if got := s.State(); got != "imitating=Tit-for-Tat" {
+		t.Errorf("estado %q, esperado imitating=Tit-for-Tat", got)
+	}
+}
+
+func TestAnnealerVarianceDecreases(t *testing.T) {
+	forbidGlobalRNG(t)
+
+	s := &Annealer{}
+	if s.temperature(0) != annealerTemperature || s.temperature(10) >= s.temperature(9) || s.temperature(1000) != annealerMinimum {
+		t.Errorf("temperaturas %v, %v, %v e %v", s.temperature(0), s.temperature(9), s.temperature(10), s.temperature(1000))
+	}
+
+	// Variância da jogada (1 = cooperar) em cada rodada, medida em vários jogos contra Always
+	// Cooperate: perto de 0,25 enquanto a temperatura alta mistura as jogadas, perto de 0 quando
+	// ela esfria e só resta trair
+	const games, rounds = 200, 150
+	cooperations := make([]int, rounds)
+	for seed := int64(0); seed < games; seed++ {
+		game := playMatch(t, &Annealer{}, AlwaysCooperate{}, rounds, seed)
+		for round, move := range game.movesA {
+			if move == Cooperate {
+				cooperations[round]++
+			}
+		}
+	}
+	variance := func(from, to int) float64 {
+		total := 0.0
+		for round := from; round < to; round++ {
+			p := float64(cooperations[round]) / games
+			total += p * (1 - p)
+		}
+		return total / float64(to-from)
+	}
+	early, middle, late := variance(0, 10), variance(40, 60), variance(130, 150)
+	if !(early > 0.2 && early > middle && middle > late && late < 0.01) {
+		t.Errorf("variância das jogadas no início %.3f, no meio %.3f e no fim %.3f", early, middle, late)
+	}
+}
+
+func TestRunningAveragePerRound(t *testing.T) {
+	// Tit-for-Tat contra um oponente que trai nas rodadas 0, 1 e 3: TFT faz 0, 1, 10, 0, 10 e 7
+	// pontos, o oponente 10, 1, 0, 10, 0 e 7. A média ao vivo desconta a pontuação inicial
+	game := NewGame(TitForTat{}, scripted("DDCD"), 6)
+	game.SetStartingScores(20, 0)
+	wantA := []float64{0, 0.5, 11.0 / 3, 2.75, 4.2, 28.0 / 6}
+	wantB := []float64{10, 5.5, 11.0 / 3, 5.25, 4.2, 28.0 / 6}
+	for round := range wantA {
+		if err := game.PlayRound(round); err != nil {
+			t.Fatal(err)
+		}
+		averageA := perRoundScore(game.scores[0]-game.handicap[0], round+1, 1)
+		averageB := perRoundScore(game.scores[1]-game.handicap[1], round+1, 1)
+		if math.Abs(averageA-wantA[round]) > 1e-9 || math.Abs(averageB-wantB[round]) > 1e-9 {
+			t.Errorf("rodada %d: médias %.3f e %.3f, esperado %.3f e %.3f", round, averageA, averageB, wantA[round], wantB[round])
+		}
+	}
+}
+
+func TestNiceRetaliatorProperties(t *testing.T) {
+	forbidGlobalRNG(t)
+
+	opponents := []Strategy{AlwaysDefect{}, &Joss{}, Grofman{}, periodicDefector{3}}
+	for _, p := range []float64{0.2, 0.5, 0.8} {
+		opponents = append(opponents, &biasedRandom{p: p})
+	}
+	for _, opponent := range opponents {
+		for seed := int64(0); seed < 5; seed++ {
+			game := playMatch(t, &NiceRetaliator{}, opponent, 100, seed)
+			own, other := game.movesA, game.movesB
+			for r := range own {
+				// Gentil e perdoa: só trai logo depois de uma traição do oponente, então nunca trai
+				// primeiro e volta a cooperar assim que o oponente coopera
+				if own[r] == Defect && (r == 0 || other[r-1] == Cooperate) {
+					t.Fatalf("contra %s traiu sem provocação na rodada %d: %s / %s", opponent.Name(), r, movesString(own), movesString(other))
+				}
+				// Proporcional: na janela, nunca mais retaliações que traições às quais respondiam
+				from := max(r-niceRetaliatorWindow+1, 1)
+				if from <= r {
+					retaliations := countMoves(own[from:r+1], Defect)
+					provocations := countMoves(other[from-1:r], Defect)
+					if retaliations > provocations {
+						t.Fatalf("contra %s, %d retaliações para %d traições nas rodadas %d a %d", opponent.Name(), retaliations, provocations, from, r)
+					}
+				}
+			}
+		}
+	}
+
+	// Retaliador: cada traição isolada é respondida uma vez, na rodada seguinte
+	game := playMatch(t, &NiceRetaliator{}, scripted("CCDCCCCDCCCCCCCCCCCCCD"), 23, 1)
+	if got, want := movesString(game.movesA), "CCCDCCCCDCCCCCCCCCCCCCD"; got != want {
+		t.Errorf("contra traições isoladas jogou %s, esperado %s", got, want)
+	}
+}
+
+func TestRoundTooltip(t *testing.T) {
+	m := defaultPayoff
+	cases := []struct {
+		moveA, moveB     Choice
+		pointsA, pointsB int
+	}{
+		{Cooperate, Cooperate, m.Reward, m.Reward},
+		{Cooperate, Defect, m.Sucker, m.Temptation},
+		{Defect, Cooperate, m.Temptation, m.Sucker},
+		{Defect, Defect, m.Punishment, m.Punishment},
+	}
+	for _, c := range cases {
+		data := roundData{moveA: c.moveA, moveB: c.moveB, pointsA: c.pointsA, pointsB: c.pointsB}
+		want := fmt.Sprintf(tr("tooltip_outcome"), moveVerb(c.moveA), moveVerb(c.moveB), c.pointsA, c.pointsB)
+		if got := roundTooltip(data, m); got != want {
+			t.Errorf("%s%s: %q, esperado %q", moveCode(c.moveA), moveCode(c.moveB), got, want)
+		}
+	}
+
+	// Um bônus de cooperação mudou os pontos efetivos, que aparecem depois dos da matriz
+	data := roundData{moveA: Cooperate, moveB: Cooperate, pointsA: m.Reward + 2, pointsB: m.Reward + 2}
+	want := fmt.Sprintf(tr("tooltip_outcome"), moveVerb(Cooperate), moveVerb(Cooperate), m.Reward, m.Reward) +
+		" " + fmt.Sprintf(tr("tooltip_adjusted"), m.Reward+2, m.Reward+2)
+	if got := roundTooltip(data, m); got != want {
+		t.Errorf("com bônus: %q, esperado %q", got, want)
+	}
+	// Basta um dos lados ter sido alterado
+	data = roundData{moveA: Defect, moveB: Defect, pointsA: m.Punishment, pointsB: m.Punishment - 3}
+	if got := roundTooltip(data, m); !strings.HasSuffix(got, fmt.Sprintf(tr("tooltip_adjusted"), m.Punishment, m.Punishment-3)) {
+		t.Errorf("com penalidade só em B: %q", got)
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// themePreferenceKey é a chave das preferências onde a escolha de tema fica salva
+const themePreferenceKey = "temaEscuro"
+
+// variantTheme usa o tema padrão do Fyne, mas sempre na variante escolhida pelo usuário
+type variantTheme struct {
+	fyne.Theme
+	variant fyne.ThemeVariant
+}
+
+func (t variantTheme) Color(name fyne.ThemeColorName, _ fyne.ThemeVariant) color.Color {
+	return t.Theme.Color(name, t.variant)
+}
+
+// applyTheme aplica o tema claro ou escuro e salva a escolha nas preferências
+func applyTheme(a fyne.App, dark bool) {
+	variant := theme.VariantLight
+	if dark {
+		variant = theme.VariantDark
+	}
+	a.Settings().SetTheme(variantTheme{Theme: theme.DefaultTheme(), variant: variant})
+	a.Preferences().SetBool(themePreferenceKey, dark)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+)
+
+// tooltipOffset afasta a dica do ponteiro, para que ela não fique sob o mouse
+var tooltipOffset = fyne.NewPos(12, 12)
+
+// tooltipLabel é um rótulo que mostra uma dica flutuante enquanto o mouse está sobre ele
+type tooltipLabel struct {
+	widget.Label
+	tooltip string
+	popup   *widget.PopUp
+}
+
+// newTooltipLabel cria um rótulo vazio, sem dica
+func newTooltipLabel() *tooltipLabel {
+	label := &tooltipLabel{}
+	label.ExtendBaseWidget(label)
+	return label
+}
+
+// SetTooltip muda o texto da dica; vazio desativa a dica
+func (l *tooltipLabel) SetTooltip(text string) {
+	l.tooltip = text
+	if l.popup != nil && text == "" {
+		l.MouseOut()
+	}
+}
+
+func (l *tooltipLabel) MouseIn(e *desktop.MouseEvent) {
+	if l.tooltip == "" {
+		return
+	}
+	canvas := fyne.CurrentApp().Driver().CanvasForObject(l)
+	if canvas == nil {
+		return
+	}
+	l.popup = widget.NewPopUp(widget.NewLabel(l.tooltip), canvas)
+	l.popup.ShowAtPosition(e.AbsolutePosition.Add(tooltipOffset))
+}
+
+func (l *tooltipLabel) MouseMoved(e *desktop.MouseEvent) {
+	if l.popup != nil {
+		l.popup.Move(e.AbsolutePosition.Add(tooltipOffset))
+	}
+}
+
+func (l *tooltipLabel) MouseOut() {
+	if l.popup != nil {
+		l.popup.Hide()
+		l.popup = nil
+	}
+}
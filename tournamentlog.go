@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TournamentRecord é o resultado de um torneio gravado em JSON, com a classificação, a matriz de
+// confrontos e as estatísticas, para que possa ser revisto sem jogar o torneio de novo
+type TournamentRecord struct {
+	Timestamp time.Time      `json:"data"`
+	Rounds    int            `json:"rodadas"`
+	Names     []string       `json:"estrategias"` // Na ordem da matriz
+	Matrix    [][]int        `json:"matriz"`
+	Results   []ResultRecord `json:"classificacao"`
+	Stats     StatsRecord    `json:"estatisticas"`
+	Settings  string         `json:"configuracao,omitempty"` // Resumo de tournamentSettings
+}
+
+// ResultRecord é a forma gravada de um Result
+type ResultRecord struct {
+	Name      string  `json:"nome"`
+	Score     int     `json:"pontuacao"`
+	Games     int     `json:"jogos"`
+	CoopRate  float64 `json:"cooperacao"`
+	Deviation float64 `json:"desvio_tft"`
+	Rank      int     `json:"posicao"`
+	Nice      bool    `json:"amavel"`
+	Stateless bool    `json:"sem_estado"`
+	Forfeits  int     `json:"desclassificacoes,omitempty"`
+}
+
+// StatsRecord é a forma gravada de TournamentStats
+type StatsRecord struct {
+	Reps        int                `json:"repeticoes"`
+	Means       map[string]float64 `json:"medias,omitempty"`
+	Margins     map[string]float64 `json:"margens,omitempty"`
+	Upset       *UpsetRecord       `json:"surpresa,omitempty"`
+	Cooperation float64            `json:"cooperacao"`
+	WinnerNice  bool               `json:"vencedora_amavel"`
+	WinnerCoop  float64            `json:"cooperacao_vencedora"`
+}
+
+// UpsetRecord é a forma gravada de um Upset
+type UpsetRecord struct {
+	Winner     string  `json:"vencedora"`
+	Loser      string  `json:"perdedora"`
+	WinnerRank int     `json:"posicao_vencedora"`
+	LoserRank  int     `json:"posicao_perdedora"`
+	Margin     float64 `json:"margem"`
+}
+
+// recordTournament monta o registro de um torneio terminado
+func recordTournament(stats TournamentStats, results []Result, matrix [][]int, rounds int) TournamentRecord {
+	record := TournamentRecord{
+		Timestamp: time.Now(),
+		Rounds:    rounds,
+		Names:     stats.names,
+		Matrix:    matrix,
+		Settings:  stats.settings,
+		Results:   make([]ResultRecord, len(results)),
+		Stats: StatsRecord{
+			Reps:        stats.reps,
+			Means:       stats.means,
+			Margins:     stats.margins,
+			Cooperation: stats.cooperation,
+			WinnerNice:  stats.winnerNice,
+			WinnerCoop:  stats.winnerCoop,
+		},
+	}
+	for i, r := range results {
+		record.Results[i] = ResultRecord{Name: r.name, Score: r.score, Games: r.games, CoopRate: r.coopRate,
+			Deviation: r.deviation, Rank: r.rank, Nice: r.nice, Stateless: r.stateless, Forfeits: r.forfeits}
+	}
+	if u := stats.upset; u != nil {
+		record.Stats.Upset = &UpsetRecord{Winner: u.winner, Loser: u.loser, WinnerRank: u.winnerRank, LoserRank: u.loserRank, Margin: u.margin}
+	}
+	return record
+}
+
+// saveTournamentResult grava o registro do torneio em JSON no arquivo path
+func saveTournamentResult(path string, record TournamentRecord) error {
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// loadTournamentResult lê um torneio gravado por saveTournamentResult e reconstrói as
+// estatísticas, a classificação e a matriz de confrontos, sem jogar nenhum confronto
+func loadTournamentResult(path string) (TournamentStats, []Result, [][]int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return TournamentStats{}, nil, nil, err
+	}
+	var record TournamentRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return TournamentStats{}, nil, nil, fmt.Errorf(tr("err_tournament_log"), path, err)
+	}
+	if len(record.Matrix) != len(record.Names) {
+		return TournamentStats{}, nil, nil, fmt.Errorf(tr("err_tournament_log"), path, tr("err_tournament_log_matrix"))
+	}
+
+	results := make([]Result, len(record.Results))
+	for i, r := range record.Results {
+		results[i] = Result{name: r.Name, score: r.Score, games: r.Games, coopRate: r.CoopRate,
+			deviation: r.Deviation, rank: r.Rank, nice: r.Nice, stateless: r.Stateless, forfeits: r.Forfeits}
+	}
+	stats := TournamentStats{
+		reps:        record.Stats.Reps,
+		means:       record.Stats.Means,
+		margins:     record.Stats.Margins,
+		cooperation: record.Stats.Cooperation,
+		winnerNice:  record.Stats.WinnerNice,
+		winnerCoop:  record.Stats.WinnerCoop,
+		names:       record.Names,
+		settings:    record.Settings,
+	}
+	if stats.means == nil {
+		stats.means = make(map[string]float64)
+	}
+	if stats.margins == nil {
+		stats.margins = make(map[string]float64)
+	}
+	if u := record.Stats.Upset; u != nil {
+		stats.upset = &Upset{winner: u.Winner, loser: u.Loser, winnerRank: u.WinnerRank, loserRank: u.LoserRank, margin: u.Margin}
+	}
+	return stats, results, record.Matrix, nil
+}
+
+// tournamentLogFiles lista os torneios gravados em dir, do mais recente ao mais antigo (pelo nome,
+// que começa com a data). Uma pasta inexistente não tem torneios
+func tournamentLogFiles(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(paths)))
+	return paths, nil
+}
+
+// tournamentLogPath retorna o caminho em dir para gravar um torneio terminado em t
+func tournamentLogPath(dir string, t time.Time) string {
+	return filepath.Join(dir, t.Format("20060102-150405.000")+".json")
+}
+
+// formatMatrix monta a matriz de confrontos como texto em colunas (para fonte monoespaçada): a
+// linha de cada estratégia tem os pontos que ela fez contra a de cada coluna
+func formatMatrix(names []string, matrix [][]int) string {
+	const nameWidth, cellWidth = 16, 8
+	short := func(name string, width int) string {
+		runes := []rune(name)
+		if len(runes) > width-1 {
+			runes = runes[:width-1]
+		}
+		return string(runes)
+	}
+	var b strings.Builder
+	b.WriteString(strings.Repeat(" ", nameWidth))
+	for j := range names {
+		fmt.Fprintf(&b, "%*d", cellWidth, j+1)
+	}
+	b.WriteString("\n")
+	for i, name := range names {
+		fmt.Fprintf(&b, "%-*s", nameWidth, fmt.Sprintf("%d. %s", i+1, short(name, nameWidth-4)))
+		for _, points := range matrix[i] {
+			fmt.Fprintf(&b, "%*d", cellWidth, points)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestTournamentResultRoundTrip(t *testing.T) {
+	strategies := []Strategy{TitForTat{}, &Joss{}, AlwaysDefect{}, AlwaysCooperate{}}
+	cfg := TournamentConfig{Rounds: 30, Seed: 4}
+	results, matrix, samples, _ := repeatTournament(strategies, cfg, 3)
+	names := make([]string, len(strategies))
+	for i, s := range strategies {
+		names[i] = s.Name()
+	}
+	stats := tournamentStats(results, matrix, names, strategyCounts(strategies, nil), samples, 3)
+	stats.settings = tournamentSettings(cfg, 3)
+	if stats.upset == nil {
+		t.Fatal("o torneio de teste deveria ter uma surpresa, para que ela também seja gravada")
+	}
+
+	path := filepath.Join(t.TempDir(), "torneio.json")
+	if err := saveTournamentResult(path, recordTournament(stats, results, matrix, cfg.Rounds)); err != nil {
+		t.Fatal(err)
+	}
+	loadedStats, loadedResults, loadedMatrix, err := loadTournamentResult(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(loadedStats, stats) {
+		t.Errorf("estatísticas relidas %+v, esperado %+v", loadedStats, stats)
+	}
+	if !reflect.DeepEqual(loadedResults, results) {
+		t.Errorf("classificação relida %+v, esperado %+v", loadedResults, results)
+	}
+	if !reflect.DeepEqual(loadedMatrix, matrix) {
+		t.Errorf("matriz relida %v, esperado %v", loadedMatrix, matrix)
+	}
+
+	// Um arquivo com a matriz incompleta é rejeitado
+	broken := filepath.Join(t.TempDir(), "quebrado.json")
+	if err := os.WriteFile(broken, []byte(`{"estrategias": ["A", "B"], "matriz": [[1, 2]]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := loadTournamentResult(broken); err == nil {
+		t.Error("um registro com a matriz incompleta deveria ser rejeitado")
+	}
+}